@@ -4,7 +4,10 @@ go 1.22.4
 
 require (
 	github.com/go-ozzo/ozzo-validation/v4 v4.3.0
+	github.com/rivo/uniseg v0.4.7
 	github.com/spf13/cobra v1.9.1
+	golang.org/x/net v0.34.0
+	golang.org/x/text v0.21.0
 )
 
 require (
@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
@@ -50,22 +51,33 @@ func (a *App) Run(ctx context.Context, args []string) error {
 	a.rootCmd.SetVersionTemplate(AppVersion)
 
 	// setup flags
-	orchestrator := newOrchestrator()
+	orchestrator := newOrchestrator(a.IO)
 	a.rootCmd.Flags().StringVar(&orchestrator.Value.raw, "value", "", "the value to validate against the specified rules")
 	a.rootCmd.Flags().StringVar(&orchestrator.Value.name, "value-name", "", "the name of the value to include in error messages")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Value.mask, "mask-value", false, "masks the value in error messages to protect sensitive data")
 	a.rootCmd.Flags().StringVar(&orchestrator.Formatter.format, "format", "default", "specifies the output format (default, github-actions)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Transformer.trim, "trim", false, "removes leading and trailing whitespace from the value before validation")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Transformer.collapseSpaces, "collapse-spaces", false, "replaces each run of whitespace in the value with a single space before validation")
+	a.rootCmd.Flags().StringVar(&orchestrator.Transformer.stripPrefix, "strip-prefix", "", "removes the specified prefix from the value before validation, such as v of v1.2.3")
+	a.rootCmd.Flags().StringVar(&orchestrator.Transformer.toNormalization, "to-normalization", "", "converts the value to the specified Unicode normalization form before validation: nfc, nfd, nfkc, nfkd (see --normalized to validate the form instead)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Transformer.lower, "lower", false, "converts the value to lower case before validation")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Transformer.upper, "upper", false, "converts the value to upper case before validation")
+	a.rootCmd.Flags().BoolVar(&orchestrator.printValue, "print-value", false, "prints the value after the transformations to standard output if the validation succeeds")
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.min, "min", "", "validates that the value is greater than or equal to the specified minimum")
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.max, "max", "", "validates that the value is less than or equal to the specified maximum")
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.exactLength, "exact-length", "", "validates that the length of value is exactly the specified number")
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.minLength, "min-length", "", "validates that the length of value is greater than or equal to the specified minimum")
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.maxLength, "max-length", "", "validates that the length of value is less than or equal to the specified maximum")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.lengthUnit, "length-unit", "", "specifies the unit of length for --exact-length, --min-length and --max-length: bytes (default), runes, graphemes, width")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.notEmpty, "not-empty", false, "validates that the value is not empty")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.digit, "digit", false, "validates that the value contains only digits (0-9)")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.alpha, "alpha", false, "validates that the value contains only English letters (a-zA-Z)")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.alphanumeric, "alphanumeric", false, "validates that the value contains only English letters and digits (a-zA-Z0-9)")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.ascii, "ascii", false, "validates that the value contains only ASCII characters")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.printableASCII, "printable-ascii", false, "validates that the value contains only printable ASCII characters")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.normalized, "normalized", "", "validates that the value is in the specified Unicode normalization form: nfc, nfd, nfkc, nfkd")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.script, "script", "", "validates that the value contains only characters of the specified Unicode scripts, besides digits and punctuation (comma-separated list, e.g. Latin,Han,Hiragana)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.unicodeCategory, "unicode-category", "", "validates that the value contains only characters of the specified Unicode general categories (comma-separated list, e.g. L,Nd)")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.lowerCase, "lower-case", false, "validates that the value contains only lowercase Unicode letters")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.upperCase, "upper-case", false, "validates that the value contains only uppercase Unicode letters")
 	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.int, "int", false, "validates that the value is an integer")
@@ -80,6 +92,111 @@ func (a *App) Run(ctx context.Context, args []string) error {
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.pattern, "pattern", "", "validates that the value matches the specified regular expression")
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.enum, "enum", "", "validates that the value matches one of the specified enumerations (comma-separated list)")
 	a.rootCmd.Flags().StringVar(&orchestrator.Validator.timestamp, "timestamp", "", "validates that the value matches the timestamp format specified in the timestamp input (rfc3339, datetime, date, or time)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.ip, "ip", false, "validates that the value is a valid IP address (IPv4 or IPv6)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.ipv4, "ipv4", false, "validates that the value is a valid IPv4 address")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.ipv6, "ipv6", false, "validates that the value is a valid IPv6 address")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.cidr, "cidr", false, "validates that the value is a valid CIDR notation")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.port, "port", false, "validates that the value is a valid port number (1-65535)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.mac, "mac", false, "validates that the value is a valid MAC address")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.hostPort, "host-port", false, "validates that the value is a valid host:port pair")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.ipIn, "ip-in", "", "validates that the value is an IP address within one of the specified CIDR blocks (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.ipExclude, "ip-exclude", "", "validates that the value is an IP address outside the specified ranges (comma-separated list of private, loopback, link-local, multicast, unspecified)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.uri, "uri", false, "validates that the value is a valid absolute URI with any scheme (e.g. s3://, data:, mailto:)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.urlReference, "url-reference", false, "validates that the value is a valid URL reference, either absolute or relative")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.urn, "urn", false, "validates that the value is a valid URN")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.urlSchemes, "url-schemes", "", "validates that the URL scheme is one of the specified schemes (comma-separated list)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.urlRequireHTTPS, "url-require-https", false, "validates that the URL uses the https scheme")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.urlAllowedHosts, "url-allowed-hosts", "", "validates that the URL host matches one of the specified hosts (comma-separated list, wildcards like *.example.com allowed)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.urlDeniedHosts, "url-denied-hosts", "", "validates that the URL host matches none of the specified hosts (comma-separated list, wildcards like *.example.com allowed)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.urlForbidUserinfo, "url-forbid-userinfo", false, "validates that the URL does not contain user information such as credentials")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.urlForbidIPHost, "url-forbid-ip-host", false, "validates that the URL host is not an IP address")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.urlQuery, "url-query", "", "validates that the URL query is present or absent (required or forbidden)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.urlFragment, "url-fragment", "", "validates that the URL fragment is present or absent (required or forbidden)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.emailMode, "email-mode", "loose", "specifies the syntax strictness of --email (loose, rfc5322, html5)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.emailDomains, "email-domains", "", "validates that the --email domain matches one of the specified domains (comma-separated list, wildcards like *.example.com allowed)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.emailForbidPlus, "email-forbid-plus", false, "validates that the --email address does not use plus addressing (e.g. user+tag@example.com)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.idn, "idn", false, "allows internationalized domain names in --domain and --email by converting them to punycode, and UTF-8 local parts in --email-mode rfc5322 and html5")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.awsARN, "aws-arn", false, "validates that the value is a valid AWS ARN")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.awsARNServices, "aws-arn-services", "", "validates that the value is an AWS ARN of one of the specified services (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.awsARNResourceTypes, "aws-arn-resource-types", "", "validates that the value is an AWS ARN of one of the specified resource types (comma-separated list)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.awsAccountID, "aws-account-id", false, "validates that the value is a valid AWS account ID")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.awsRegion, "aws-region", false, "validates that the value is a valid AWS region")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.gcpProjectID, "gcp-project-id", false, "validates that the value is a valid GCP project ID")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.azureResourceID, "azure-resource-id", false, "validates that the value is a valid Azure resource ID")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.imageRef, "image-ref", false, "validates that the value is a valid container image reference (registry/repository:tag@digest)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.imageRequireDigest, "image-require-digest", false, "validates that the value is a container image reference pinned by digest")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.imageAllowedRegistries, "image-allowed-registries", "", "validates that the value is a container image reference from one of the specified registries (comma-separated list, wildcards like *.example.com allowed)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.imageForbidLatest, "image-forbid-latest", false, "validates that the value is a container image reference not using the latest tag, either explicitly or implicitly")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.digest, "digest", "", "validates that the value is a valid content digest of the specified algorithm (sha256, sha384, sha512)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.gitRefName, "git-ref-name", false, "validates that the value is a valid git ref name, such as a branch or tag name")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.gitBranchPatterns, "git-branch-patterns", "", "validates that the value matches one of the specified branch name glob patterns (comma-separated list, e.g. feature/*,release/*)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.commitSHA, "commit-sha", "", "validates that the value is a valid commit SHA of the specified kind (sha1, sha256, full, or short)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.gitTagAbsent, "git-tag-absent", false, "validates that the value is not an existing tag in the git repository of the working directory")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.gitRefExists, "git-ref-exists", false, "validates that the value is an existing ref in the git repository of the working directory")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.githubRepo, "github-repo", false, "validates that the value is a valid GitHub repository (owner/repo)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.githubUser, "github-user", false, "validates that the value is a valid GitHub username or organization name")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.githubActionRef, "github-action-ref", false, "validates that the value is a valid GitHub Actions reference (owner/repo[/path]@ref, ./path, or docker://image)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.githubActionPinned, "github-action-pinned", false, "validates that the value is a GitHub Actions reference pinned to a full-length commit SHA")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.k8sName, "k8s-name", "", "validates that the value is a valid Kubernetes resource name of the specified kind (dns1123-label, dns1123-subdomain, or path-segment)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.k8sLabelKey, "k8s-label-key", false, "validates that the value is a valid Kubernetes label key")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.k8sLabelValue, "k8s-label-value", false, "validates that the value is a valid Kubernetes label value")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.k8sAnnotationKey, "k8s-annotation-key", false, "validates that the value is a valid Kubernetes annotation key")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.k8sQuantity, "k8s-quantity", false, "validates that the value is a valid Kubernetes resource quantity (e.g. 500m, 1.5Gi)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.semverRange, "semver-range", "", "validates that the value is a semantic version satisfying the specified range (e.g. \">=1.4.0 <2.0.0\", \"^1.2.3 || ~2.0.0\")")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.semverNoPrerelease, "semver-no-prerelease", false, "validates that the value is a semantic version without prerelease identifiers")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.semverGreaterThan, "semver-greater-than", "", "validates that the value is a semantic version greater than the specified version, or the version in the file specified with @file")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.semverAllowV, "semver-allow-v", false, "allows a leading \"v\" in the value for --semver-range, --semver-no-prerelease and --semver-greater-than, which is always allowed with --semver")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.versionScheme, "version-scheme", "", "validates that the value is a valid version of the specified scheme (semver, calver, pep440, debian, or go)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.minVersion, "min-version", "", "validates that the value is greater than or equal to the specified version of --version-scheme")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.maxVersion, "max-version", "", "validates that the value is less than or equal to the specified version of --version-scheme")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.spdx, "spdx", false, "validates that the value is a valid SPDX license identifier or expression (e.g. \"MIT OR Apache-2.0\")")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.spdxAllowed, "spdx-allowed", "", "validates that every license in the SPDX license expression is one of the specified licenses (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.spdxDenied, "spdx-denied", "", "validates that no license in the SPDX license expression is one of the specified licenses (comma-separated list)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.conventionalCommit, "conventional-commit", false, "validates that the value is a valid Conventional Commits message or PR title")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.conventionalCommitTypes, "conventional-commit-types", "", "validates that the type of the Conventional Commits message is one of the specified types (comma-separated list, default: build,chore,ci,docs,feat,fix,perf,refactor,revert,style,test)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.conventionalCommitScopes, "conventional-commit-scopes", "", "validates that the scope of the Conventional Commits message, if any, is one of the specified scopes (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.conventionalCommitMaxSubjectLength, "conventional-commit-max-subject-length", "", "validates that the subject of the Conventional Commits message is less than or equal to the specified length")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.conventionalCommitSubjectCase, "conventional-commit-subject-case", "", "validates that the subject of the Conventional Commits message starts with the specified case (lower or sentence)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.dsn, "dsn", "", "validates that the value is a valid connection string of the specified format (postgres, mysql, redis, amqp, jdbc, or mongodb), without showing the password in error messages")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.dsnRequireSSLMode, "dsn-require-sslmode", false, "validates that the postgres connection string specifies sslmode")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.pem, "pem", "", "validates that the value is a valid PEM-encoded material of the specified type (certificate, private-key, public-key, or csr)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.certNotExpired, "cert-not-expired", false, "validates that the PEM-encoded certificate is currently valid")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.certMinValidity, "cert-min-validity", "", "validates that the PEM-encoded certificate remains valid for at least the specified duration (e.g. 720h, 30d)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.certHostname, "cert-hostname", "", "validates that the PEM-encoded certificate is valid for the specified hostname")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.sshPublicKey, "ssh-public-key", false, "validates that the value is a valid SSH public key in the authorized_keys format")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.gpgFingerprint, "gpg-fingerprint", false, "validates that the value is a valid GPG key fingerprint")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.keyAlgorithms, "key-algorithms", "", "validates that the key of --pem or --ssh-public-key uses one of the specified algorithms (comma-separated list of rsa, ecdsa, ed25519, or dsa)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.minKeySize, "min-key-size", "", "validates that the key of --pem or --ssh-public-key is greater than or equal to the specified size in bits")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.jwt, "jwt", false, "validates that the value is a valid JWT, which is not expired and not used before nbf (the token is always masked in error messages)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.jwtAlgorithms, "jwt-algorithms", "", "validates that the alg of the JWT is one of the specified algorithms (comma-separated list, \"none\" is rejected unless specified)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.jwtIssuers, "jwt-issuers", "", "validates that the iss of the JWT is one of the specified issuers (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.jwtAudiences, "jwt-audiences", "", "validates that the aud of the JWT contains one of the specified audiences (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.jwtKey, "jwt-key", "", "validates the signature of the JWT with the key file (a shared secret for HMAC, or a PEM-encoded public key or certificate)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.passwordPolicy, "password-policy", false, "validates that the value meets the default password policy, which the --password-* options override (the value is always masked in error messages)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.passwordMinLength, "password-min-length", "", "validates that the password is at least the specified number of characters (default with --password-policy: 12)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.passwordRequire, "password-require", "", "validates that the password contains the specified character classes (comma-separated list of lower, upper, digit, or symbol; default with --password-policy: lower,upper,digit)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.passwordMaxRepeat, "password-max-repeat", "", "validates that the password does not repeat the same character more than the specified times in a row (default with --password-policy: 3)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.passwordMaxSequence, "password-max-sequence", "", "validates that the password does not contain sequential characters such as \"abcd\" or \"4321\" longer than the specified length (default with --password-policy: 4)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.passwordDictionary, "password-dictionary", "", "validates that the password contains neither common passwords nor the words in the specified file (common passwords are checked by default with --password-policy)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.passwordMinEntropy, "password-min-entropy", "", "validates that the estimated entropy of the password is at least the specified bits (default with --password-policy: 50)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.notBreached, "not-breached", "", "validates that the SHA-1 hash of the value is not in the specified file sorted by hash, or the directory of hash prefix buckets (the value is always masked in error messages)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.noSecrets, "no-secrets", false, "validates that the value contains no secrets such as API tokens, private keys, or random-looking strings (the value is masked in error messages when found)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.safeFor, "safe-for", "", "validates that the value is safe to interpolate into the specified contexts: shell, sql-identifier, html, filename, path-segment (comma-separated list)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.noInvisible, "no-invisible", false, "validates that the value contains no invisible characters such as zero-width spaces and control characters")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.noBidiControls, "no-bidi-controls", false, "validates that the value contains no bidirectional control characters which reorder the displayed text")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.noConfusables, "no-confusables", false, "validates that the value contains no non-ASCII characters that look like ASCII characters, such as Cyrillic a")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.noMixedScripts, "no-mixed-scripts", false, "validates that the value does not mix scripts such as Latin and Cyrillic, except combinations used in Chinese, Japanese and Korean")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.startsWith, "starts-with", "", "validates that the value starts with the specified string, matched literally")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.startsWithAny, "starts-with-any", "", "validates that the value starts with one of the specified strings, matched literally (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.endsWith, "ends-with", "", "validates that the value ends with the specified string, matched literally")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.endsWithAny, "ends-with-any", "", "validates that the value ends with one of the specified strings, matched literally (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.contains, "contains", "", "validates that the value contains the specified string, matched literally")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.containsAny, "contains-any", "", "validates that the value contains one of the specified strings, matched literally (comma-separated list)")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.notContains, "not-contains", "", "validates that the value does not contain the specified string, matched literally")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.notContainsAny, "not-contains-any", "", "validates that the value contains none of the specified strings, matched literally (comma-separated list)")
+	a.rootCmd.Flags().BoolVar(&orchestrator.Validator.ignoreCase, "ignore-case", false, "makes --starts-with, --ends-with, --contains, --not-contains and their any-of variants case-insensitive")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.charset, "charset", "", "validates that the value contains only characters of the specified set, with ranges and classes like a-z0-9_- or \\p{L}\\p{Nd}")
+	a.rootCmd.Flags().StringVar(&orchestrator.Validator.notCharset, "not-charset", "", "validates that the value contains no characters of the specified set, with ranges and classes like <>&\"' or \\p{Cc}")
 
 	a.rootCmd.RunE = func(cmd *cobra.Command, args []string) error { return orchestrator.Orchestrate() }
 	return a.rootCmd.Execute()
@@ -91,7 +208,23 @@ func (a *App) setupLog(args []string) {
 		log.SetOutput(a.IO.ErrWriter)
 	}
 	log.SetPrefix(fmt.Sprintf("[%s] ", AppName))
-	log.Printf("Start: args: %v", args)
+	log.Printf("Start: args: %v", redactValueArgs(args))
+}
+
+// redactValueArgs masks the value of --value, since the value may be sensitive, such as passwords.
+func redactValueArgs(args []string) []string {
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		switch {
+		case i > 0 && args[i-1] == "--value":
+			redacted[i] = MaskedValue
+		case strings.HasPrefix(arg, "--value="):
+			redacted[i] = "--value=" + MaskedValue
+		default:
+			redacted[i] = arg
+		}
+	}
+	return redacted
 }
 
 func (a *App) isDebug() bool {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -73,3 +74,48 @@ func FakeTestIO() *IO {
 		ErrWriter: os.Stderr,
 	}
 }
+
+func TestApp_Run_DebugLog(t *testing.T) {
+	cases := []struct {
+		annotation string
+		args       []string
+		expected   string
+	}{
+		{"separated", []string{"--not-breached", "hashes.txt", "--value", "p@ssw0rd"}, "Start: args: [--not-breached hashes.txt --value ***]"},
+		{"equal_sign", []string{"--value=p@ssw0rd", "--not-empty"}, "Start: args: [--value=*** --not-empty]"},
+	}
+
+	t.Setenv("VALID_DEBUG", "true")
+	for _, tc := range cases {
+		stderr := &bytes.Buffer{}
+		sut := NewApp(&IO{InReader: &bytes.Buffer{}, OutWriter: &bytes.Buffer{}, ErrWriter: stderr})
+		_ = sut.Run(context.Background(), tc.args)
+
+		format := "\n expected: %s\n actual:   %s\n args:     %v"
+		if !strings.Contains(stderr.String(), tc.expected) || strings.Contains(stderr.String(), "p@ssw0rd") {
+			t.Errorf(fmt.Sprintf(format, tc.expected, stderr.String(), tc.args))
+		}
+	}
+}
+
+func TestApp_Run_PrintValue(t *testing.T) {
+	cases := []struct {
+		annotation string
+		args       []string
+		expected   string
+	}{
+		{"normalized", []string{"--trim", "--strip-prefix", "v", "--semver", "--print-value", "--value", " v1.2.3\n"}, "1.2.3\n"},
+		{"invalid", []string{"--trim", "--digit", "--print-value", "--value", " 12a "}, ""},
+	}
+
+	for _, tc := range cases {
+		stdout := &bytes.Buffer{}
+		sut := NewApp(&IO{InReader: &bytes.Buffer{}, OutWriter: stdout, ErrWriter: os.Stderr})
+		_ = sut.Run(context.Background(), tc.args)
+
+		format := "\n expected: %q\n actual:   %q\n args:     %v"
+		if stdout.String() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.expected, stdout.String(), tc.args))
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func (v *Validator) notBreachedValidate() {
+	if v.notBreached == "" {
+		return
+	}
+	// breached passwords never appear in error messages, even if --mask-value isn't specified
+	v.MaskValue()
+
+	info, err := os.Stat(v.notBreached)
+	if err != nil {
+		v.AddArgumentError(fmt.Errorf("--not-breached cannot read the file: %s", v.notBreached))
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	sum := sha1.Sum([]byte(v.UnmaskedValue))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	var breached bool
+	if info.IsDir() {
+		breached, err = searchHashBucket(v.notBreached, hash)
+	} else {
+		breached, err = searchSortedHashFile(v.notBreached, hash)
+	}
+	if err != nil {
+		v.AddArgumentError(fmt.Errorf("--not-breached cannot read the file: %s", v.notBreached))
+		return
+	}
+	if breached {
+		v.AddValidationError(fmt.Errorf("must not appear in known data breaches"))
+	}
+}
+
+// searchSortedHashFile searches the hash by binary search on the file sorted by hash,
+// such as the "ordered by hash" SHA-1 list of Have I Been Pwned, without loading the whole file.
+// Each line is a hex-encoded SHA-1 hash, optionally followed by ":" and the count.
+func searchSortedHashFile(name string, hash string) (bool, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	// lines starting in [low, high) are the candidates, where low is always the start of a line
+	size := info.Size()
+	low, high := int64(0), size
+	for low < high {
+		start, err := nextLineStart(file, size, low+(high-low)/2)
+		if err != nil {
+			return false, err
+		}
+		if start >= high {
+			high = low + (high-low)/2
+			continue
+		}
+		line, err := readLine(file, size, start)
+		if err != nil {
+			return false, err
+		}
+
+		switch key := hashKey(line); {
+		case key == hash:
+			return true, nil
+		case key < hash:
+			low = start + int64(len(line))
+		default:
+			high = start
+		}
+	}
+	return false, nil
+}
+
+// searchHashBucket searches the hash in the directory of k-anonymity buckets, like the range API
+// of Have I Been Pwned. Each bucket is named by the first five characters of the hash, with or
+// without the ".txt" extension, and each line is the rest of the hash, optionally followed by ":" and the count.
+func searchHashBucket(dir string, hash string) (bool, error) {
+	prefix, suffix := hash[:5], hash[5:]
+	for _, name := range []string{prefix, prefix + ".txt", strings.ToLower(prefix), strings.ToLower(prefix) + ".txt"} {
+		file, err := os.Open(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if hashKey(scanner.Text()) == suffix {
+				return true, nil
+			}
+		}
+		return false, scanner.Err()
+	}
+	return false, nil
+}
+
+// nextLineStart returns the offset of the first line starting at or after the offset.
+func nextLineStart(file *os.File, size int64, offset int64) (int64, error) {
+	if offset == 0 {
+		return 0, nil
+	}
+	reader := bufio.NewReader(io.NewSectionReader(file, offset-1, size-offset+1))
+	skipped, err := reader.ReadString('\n')
+	if errors.Is(err, io.EOF) {
+		return size, nil
+	}
+	return offset - 1 + int64(len(skipped)), err
+}
+
+// readLine returns the line starting at the offset, including the trailing newline if any.
+func readLine(file *os.File, size int64, offset int64) (string, error) {
+	reader := bufio.NewReader(io.NewSectionReader(file, offset, size-offset))
+	line, err := reader.ReadString('\n')
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+	return line, err
+}
+
+func hashKey(line string) string {
+	key, _, _ := strings.Cut(line, ":")
+	return strings.ToUpper(strings.TrimSpace(key))
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestValidator_notBreachedValidate(t *testing.T) {
+	dir := t.TempDir()
+	breached := []string{"password", "123456", "qwerty", "letmein", "dragon", "monkey", "iloveyou"}
+	var hashes []string
+	for i := 0; i < 200; i++ {
+		hashes = append(hashes, sha1HexForTest(fmt.Sprintf("filler-%d", i)))
+	}
+	for _, password := range breached {
+		hashes = append(hashes, sha1HexForTest(password))
+	}
+	slices.Sort(hashes)
+
+	withCounts := filepath.Join(dir, "with-counts.txt")
+	writeTestFile(t, withCounts, strings.Join(hashes, ":42\r\n")+":42\r\n")
+	withoutCounts := filepath.Join(dir, "without-counts.txt")
+	writeTestFile(t, withoutCounts, strings.ToLower(strings.Join(hashes, "\n")))
+	buckets := filepath.Join(dir, "buckets")
+	for _, hash := range hashes {
+		writeTestFile(t, filepath.Join(buckets, hash[:5]+".txt"), hash[5:]+":3\n")
+	}
+
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"password", "must not appear in known data breaches"},
+		{"iloveyou", "must not appear in known data breaches"},
+		{"xK9#mQ2$vL7@pR4!", ""},
+		{"Password", ""},
+		{"", ""},
+	}
+
+	for _, file := range []string{withCounts, withoutCounts, buckets} {
+		for _, tc := range cases {
+			sut := newValidatorSut(tc.value)
+			sut.notBreached = file
+			sut.notBreachedValidate()
+			// breached passwords are always masked
+			assert(t, tc.expected, sut.Errors, MaskedValue, filepath.Base(file))
+		}
+	}
+
+	// every line including the first and the last is found by binary search
+	for _, hash := range hashes {
+		for _, file := range []string{withCounts, withoutCounts} {
+			if found, err := searchSortedHashFile(file, hash); err != nil || !found {
+				t.Errorf(fmt.Sprintf("\n expected: found\n actual:   %v, %v\n hash:     %s\n file:     %s", found, err, hash, file))
+			}
+		}
+	}
+}
+
+func TestValidator_notBreachedValidate_ArgumentError(t *testing.T) {
+	sut := newValidatorSut("password")
+	sut.notBreached = "not-found"
+	sut.notBreachedValidate()
+
+	expected := "Argument error: --not-breached cannot read the file: not-found."
+	if sut.Errors.Error() != expected {
+		t.Errorf(fmt.Sprintf("\n expected: %s\n actual:   %s", expected, sut.Errors.Error()))
+	}
+}
+
+func sha1HexForTest(value string) string {
+	sum := sha1.Sum([]byte(value))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
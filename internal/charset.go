@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"unicode"
+)
+
+func (v *Validator) charsetValidate() {
+	if v.charset == "" {
+		return
+	}
+
+	ranges, ok := parseCharset(v.charset)
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--charset \"%s\" is not a valid character set", v.charset))
+		return
+	}
+	if i, r, found := findRune(v.UnmaskedValue, func(r rune) bool { return !inCharset(ranges, r) }); found {
+		issue := fmt.Sprintf("must only contain characters of [%s]", v.charset)
+		v.AddValidationError(newDetailedError(issue, fmt.Sprintf("found %q at index %d", string(r), i)))
+	}
+}
+
+func (v *Validator) notCharsetValidate() {
+	if v.notCharset == "" {
+		return
+	}
+
+	ranges, ok := parseCharset(v.notCharset)
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--not-charset \"%s\" is not a valid character set", v.notCharset))
+		return
+	}
+	if i, r, found := findRune(v.UnmaskedValue, func(r rune) bool { return inCharset(ranges, r) }); found {
+		issue := fmt.Sprintf("must not contain characters of [%s]", v.notCharset)
+		v.AddValidationError(newDetailedError(issue, fmt.Sprintf("found %q at index %d", string(r), i)))
+	}
+}
+
+// parseCharset parses the character set in the syntax of the bracketed character class of regular expressions
+// without the brackets, such as "a-z0-9_-", "\p{L}\p{Nd}", "[:alpha:]", and "^<>" for the negation.
+// It returns the ranges of the characters as the pairs of the lowest and highest characters.
+func parseCharset(charset string) ([]rune, bool) {
+	re, err := syntax.Parse("["+charset+"]", syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+	// the parser simplifies some character classes into other operators, such as "xX" into the literal
+	// matching case-insensitively, and "\s\S" into any character
+	switch {
+	case re.Op == syntax.OpCharClass:
+		return re.Rune, true
+	case re.Op == syntax.OpLiteral && len(re.Rune) == 1:
+		ranges := []rune{re.Rune[0], re.Rune[0]}
+		if re.Flags&syntax.FoldCase != 0 {
+			for r := unicode.SimpleFold(re.Rune[0]); r != re.Rune[0]; r = unicode.SimpleFold(r) {
+				ranges = append(ranges, r, r)
+			}
+		}
+		return ranges, true
+	case re.Op == syntax.OpAnyChar:
+		return []rune{0, unicode.MaxRune}, true
+	case re.Op == syntax.OpAnyCharNotNL:
+		return []rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune}, true
+	default:
+		// the character set closes the brackets in the middle, such as "a]b[c"
+		return nil, false
+	}
+}
+
+func inCharset(ranges []rune, r rune) bool {
+	for i := 0; i+1 < len(ranges); i += 2 {
+		if ranges[i] <= r && r <= ranges[i+1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidator_charsetValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"range", "my-app_01", "a-z0-9_-", ""},
+		{"range invalid", "my-App_01", "a-z0-9_-", `must only contain characters of [a-z0-9_-], but found "A" at index 3`},
+		{"single character", "aaa", "a", ""},
+		{"single character invalid", "aab", "a", `must only contain characters of [a], but found "b" at index 2`},
+		{"unicode class", "日本語123", `\p{L}\p{Nd}`, ""},
+		{"unicode class invalid", "日本語 123", `\p{L}\p{Nd}`, `must only contain characters of [\p{L}\p{Nd}], but found " " at index 3`},
+		{"script class", "ひらがな", `\p{Hiragana}`, ""},
+		{"posix class", "abc123", "[:alnum:]", ""},
+		{"perl class", "abc\t", `\w`, `must only contain characters of [\w], but found "\t" at index 3`},
+		{"negated", "hello", "^<>", ""},
+		{"negated invalid", "a<b", "^<>", `must only contain characters of [^<>], but found "<" at index 1`},
+		{"escaped", "a]b", `a-z\]`, ""},
+		{"case pair", "xXx", "xX", ""},
+		{"case pair invalid", "xXy", "xX", `must only contain characters of [xX], but found "y" at index 2`},
+		{"case pair reversed", "aA", "Aa", ""},
+		{"any character", "a\nb", `\s\S`, ""},
+		{"not newline", "a b", `^\n`, ""},
+		{"not newline invalid", "a\nb", `^\n`, `must only contain characters of [^\n], but found "\n" at index 1`},
+		{"empty", "", "a-z", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.charset = tc.argument
+		sut.charsetValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_notCharsetValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"literal", "hello world", `<>&"'`, ""},
+		{"literal invalid", "Tom & Jerry", `<>&"'`, `must not contain characters of [<>&"'], but found "&" at index 4`},
+		{"unicode class", "line1 line2", `\p{Cc}`, ""},
+		{"unicode class invalid", "line1\nline2", `\p{Cc}`, `must not contain characters of [\p{Cc}], but found "\n" at index 5`},
+		{"range invalid", "файл9", "0-9", `must not contain characters of [0-9], but found "9" at index 4`},
+		{"case pair", "abc", "xX", ""},
+		{"case pair invalid", "box", "xX", `must not contain characters of [xX], but found "x" at index 2`},
+		{"case pair upper invalid", "BAD", "Aa", `must not contain characters of [Aa], but found "A" at index 1`},
+		{"not newline", "a\nb", `^\n`, `must not contain characters of [^\n], but found "a" at index 0`},
+		{"any character", "a", `\d\D`, `must not contain characters of [\d\D], but found "a" at index 0`},
+		{"empty", "", "a-z", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.notCharset = tc.argument
+		sut.notCharsetValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_charsetValidate_Masked(t *testing.T) {
+	cases := []struct {
+		annotation string
+		charset    string
+		notCharset string
+		expected   string
+	}{
+		{"charset", "a-z", "", `Validation error: The specified value "***" is invalid. Issues: must only contain characters of [a-z].`},
+		{"not-charset", "", "0-9", `Validation error: The specified value "***" is invalid. Issues: must not contain characters of [0-9].`},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("hunter2!")
+		sut.MaskValue()
+		sut.charset = tc.charset
+		sut.notCharset = tc.notCharset
+		sut.charsetValidate()
+		sut.notCharsetValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
+
+func TestValidator_charsetValidate_ArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation string
+		charset    string
+		notCharset string
+		expected   string
+	}{
+		{"invalid range", "z-a", "", `Argument error: --charset "z-a" is not a valid character set.`},
+		{"closing bracket", "a]b[c", "", `Argument error: --charset "a]b[c" is not a valid character set.`},
+		{"unknown class", "", `\p{Klingon}`, `Argument error: --not-charset "\p{Klingon}" is not a valid character set.`},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("value")
+		sut.charset = tc.charset
+		sut.notCharset = tc.notCharset
+		sut.charsetValidate()
+		sut.notCharsetValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
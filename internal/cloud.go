@@ -0,0 +1,175 @@
+package internal
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v *Validator) awsARNValidate() {
+	if !v.awsARN && v.awsARNServices == "" && v.awsARNResourceTypes == "" {
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	arn, ok := parseAWSARN(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid AWS ARN"))
+		return
+	}
+	if v.awsARNServices != "" {
+		services := splitList(v.awsARNServices)
+		if !slices.Contains(services, arn.service) {
+			v.AddValidationError(fmt.Errorf("service must be one of %v", services))
+		}
+	}
+	if v.awsARNResourceTypes != "" {
+		types := splitList(v.awsARNResourceTypes)
+		if !slices.Contains(types, arn.resourceType()) {
+			v.AddValidationError(fmt.Errorf("resource type must be one of %v", types))
+		}
+	}
+}
+
+func (v *Validator) awsAccountIDValidate() {
+	if !v.awsAccountID {
+		return
+	}
+	v.wrapValidate(validation.Match(awsAccountIDRegexp).Error("must be a valid AWS account ID"))
+}
+
+func (v *Validator) awsRegionValidate() {
+	if !v.awsRegion {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isAWSRegion, "must be a valid AWS region"))
+}
+
+func (v *Validator) gcpProjectIDValidate() {
+	if !v.gcpProjectID {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isGCPProjectID, "must be a valid GCP project ID"))
+}
+
+func (v *Validator) azureResourceIDValidate() {
+	if !v.azureResourceID {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isAzureResourceID, "must be a valid Azure resource ID"))
+}
+
+type awsARN struct {
+	partition string
+	service   string
+	region    string
+	account   string
+	resource  string
+}
+
+// resourceType returns the leading segment of the resource, such as "role" in "role/example".
+func (a *awsARN) resourceType() string {
+	if i := strings.IndexAny(a.resource, "/:"); i >= 0 {
+		return a.resource[:i]
+	}
+	return ""
+}
+
+// parseAWSARN parses value in the form of "arn:partition:service:region:account-id:resource".
+func parseAWSARN(value string) (*awsARN, bool) {
+	parts := strings.SplitN(value, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return nil, false
+	}
+
+	arn := &awsARN{partition: parts[1], service: parts[2], region: parts[3], account: parts[4], resource: parts[5]}
+	if !slices.Contains(awsPartitions, arn.partition) || !awsServiceRegexp.MatchString(arn.service) {
+		return nil, false
+	}
+	if arn.region != "" && !isAWSRegion(arn.region) {
+		return nil, false
+	}
+	if arn.account != "" && arn.account != "aws" && !awsAccountIDRegexp.MatchString(arn.account) {
+		return nil, false
+	}
+	if arn.resource == "" || strings.ContainsFunc(arn.resource, isControlOrSpace) {
+		return nil, false
+	}
+	return arn, true
+}
+
+func isAWSRegion(value string) bool {
+	return slices.Contains(awsRegions, value)
+}
+
+// isGCPProjectID follows the naming rules of Google Cloud project IDs:
+// 6 to 30 lowercase letters, digits or hyphens, starting with a letter and not ending with a hyphen.
+func isGCPProjectID(value string) bool {
+	return gcpProjectIDRegexp.MatchString(value) && !strings.Contains(value, "google")
+}
+
+// isAzureResourceID checks the hierarchy of Azure Resource Manager IDs, such as
+// "/subscriptions/{id}/resourceGroups/{name}/providers/{namespace}/{type}/{name}".
+// Subscription and resource group scoped IDs are also accepted.
+func isAzureResourceID(value string) bool {
+	segments := strings.Split(value, "/")
+	if len(segments) < 3 || segments[0] != "" || !strings.EqualFold(segments[1], "subscriptions") || !isUUID(segments[2]) {
+		return false
+	}
+	segments = segments[3:]
+	if len(segments) == 0 {
+		return true
+	}
+
+	if strings.EqualFold(segments[0], "resourceGroups") {
+		if len(segments) < 2 || !azureResourceGroupRegexp.MatchString(segments[1]) || strings.HasSuffix(segments[1], ".") {
+			return false
+		}
+		segments = segments[2:]
+		if len(segments) == 0 {
+			return true
+		}
+	}
+
+	if len(segments) < 4 || !strings.EqualFold(segments[0], "providers") || !azureNamespaceRegexp.MatchString(segments[1]) {
+		return false
+	}
+	segments = segments[2:]
+	if len(segments)%2 != 0 {
+		return false
+	}
+	for _, segment := range segments {
+		if segment == "" || strings.ContainsFunc(segment, isControlOrSpace) {
+			return false
+		}
+	}
+	return true
+}
+
+func isUUID(value string) bool {
+	return uuidRegexp.MatchString(value)
+}
+
+func isControlOrSpace(r rune) bool {
+	return r <= ' ' || r == 0x7f
+}
+
+//go:embed data/aws-regions.txt
+var awsRegionsData string
+
+var awsRegions = strings.Fields(awsRegionsData)
+
+var awsPartitions = []string{"aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b", "aws-iso-e", "aws-iso-f", "aws-eusc"}
+
+var awsServiceRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+var awsAccountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+var gcpProjectIDRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+var azureResourceGroupRegexp = regexp.MustCompile(`^[-\w._()]{1,90}$`)
+var azureNamespaceRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+(\.[a-zA-Z0-9]+)+$`)
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestValidator_awsARNValidate(t *testing.T) {
+	cases := []struct {
+		annotation    string
+		value         string
+		services      string
+		resourceTypes string
+		expected      string
+	}{
+		{"valid1", "arn:aws:iam::123456789012:role/example", "", "", ""},
+		{"valid2", "arn:aws:s3:::example-bucket/key", "", "", ""},
+		{"valid3", "arn:aws:lambda:ap-northeast-1:123456789012:function:example", "", "", ""},
+		{"valid4", "arn:aws:iam::aws:policy/AdministratorAccess", "", "", ""},
+		{"valid5", "arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-1234567890abcdef0", "", "", ""},
+		{"invalid1", "arn:aws:iam::12345678901:role/example", "", "", "must be a valid AWS ARN"},
+		{"invalid2", "arn:aws:lambda:ap-northeast-9:123456789012:function:example", "", "", "must be a valid AWS ARN"},
+		{"invalid3", "arn:amazon:iam::123456789012:role/example", "", "", "must be a valid AWS ARN"},
+		{"invalid4", "arn:aws:iam::123456789012:", "", "", "must be a valid AWS ARN"},
+		{"invalid5", "arn:aws:iam:123456789012:role/example", "", "", "must be a valid AWS ARN"},
+		{"services_valid", "arn:aws:iam::123456789012:role/example", "iam,sts", "", ""},
+		{"services_invalid", "arn:aws:s3:::example-bucket", "iam,sts", "", "service must be one of [iam sts]"},
+		{"resource_types_valid", "arn:aws:lambda:us-east-1:123456789012:function:example", "", "function", ""},
+		{"resource_types_invalid", "arn:aws:iam::123456789012:user/example", "iam", "role", "resource type must be one of [role]"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.awsARN = true
+		sut.awsARNServices = tc.services
+		sut.awsARNResourceTypes = tc.resourceTypes
+		sut.awsARNValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_awsAccountIDValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid", "123456789012", ""},
+		{"invalid1", "12345678901", "must be a valid AWS account ID"},
+		{"invalid2", "12345678901a", "must be a valid AWS account ID"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.awsAccountID = true
+		sut.awsAccountIDValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_awsRegionValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "ap-northeast-1", ""},
+		{"valid2", "us-gov-west-1", ""},
+		{"invalid1", "ap-northeast-9", "must be a valid AWS region"},
+		{"invalid2", "US-EAST-1", "must be a valid AWS region"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.awsRegion = true
+		sut.awsRegionValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_gcpProjectIDValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "example-project", ""},
+		{"valid2", "project-123456", ""},
+		{"invalid1", "short", "must be a valid GCP project ID"},
+		{"invalid2", "1-example-project", "must be a valid GCP project ID"},
+		{"invalid3", "example-project-", "must be a valid GCP project ID"},
+		{"invalid4", "Example-Project", "must be a valid GCP project ID"},
+		{"invalid5", "my-google-project", "must be a valid GCP project ID"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.gcpProjectID = true
+		sut.gcpProjectIDValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_azureResourceIDValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "/subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925", ""},
+		{"valid2", "/subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925/resourceGroups/example-rg", ""},
+		{"valid3", "/subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925/resourceGroups/example-rg/providers/Microsoft.Storage/storageAccounts/example", ""},
+		{"valid4", "/subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925/resourceGroups/example-rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default", ""},
+		{"invalid1", "subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925", "must be a valid Azure resource ID"},
+		{"invalid2", "/subscriptions/example/resourceGroups/example-rg", "must be a valid Azure resource ID"},
+		{"invalid3", "/subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925/resourceGroups/example-rg.", "must be a valid Azure resource ID"},
+		{"invalid4", "/subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925/resourceGroups/example-rg/providers/Microsoft.Storage/storageAccounts", "must be a valid Azure resource ID"},
+		{"invalid5", "/subscriptions/b4563933-7bf8-4d9b-b4cd-d0c6f85b5925/resourceGroups/example-rg/providers/Storage/storageAccounts/example", "must be a valid Azure resource ID"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.azureResourceID = true
+		sut.azureResourceIDValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func (v *Validator) conventionalCommitValidate() {
+	if !v.conventionalCommit && v.conventionalCommitTypes == "" && v.conventionalCommitScopes == "" &&
+		v.conventionalCommitMaxSubjectLength == "" && v.conventionalCommitSubjectCase == "" {
+		return
+	}
+
+	maxSubjectLength := 0
+	if v.conventionalCommitMaxSubjectLength != "" {
+		number, err := strconv.Atoi(v.conventionalCommitMaxSubjectLength)
+		if err != nil || number < 1 {
+			v.AddArgumentError(fmt.Errorf("--conventional-commit-max-subject-length must be a positive integer number"))
+			return
+		}
+		maxSubjectLength = number
+	}
+	subjectCase := strings.ToLower(v.conventionalCommitSubjectCase)
+	if subjectCase != "" && subjectCase != "lower" && subjectCase != "sentence" {
+		v.AddArgumentError(fmt.Errorf("--conventional-commit-subject-case must be one of [lower sentence]"))
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	lines := strings.Split(strings.ReplaceAll(v.UnmaskedValue, "\r\n", "\n"), "\n")
+	matches := conventionalCommitHeaderRegexp.FindStringSubmatch(lines[0])
+	if matches == nil {
+		v.AddValidationError(fmt.Errorf("header must be in the format of \"type(scope)!: subject\""))
+	} else {
+		commitType, scope, subject := matches[1], matches[3], matches[5]
+		types := defaultConventionalCommitTypes
+		if v.conventionalCommitTypes != "" {
+			types = splitList(v.conventionalCommitTypes)
+		}
+		if !slices.Contains(types, commitType) {
+			v.AddValidationError(fmt.Errorf("type must be one of %v", types))
+		}
+		if v.conventionalCommitScopes != "" && matches[2] != "" {
+			scopes := splitList(v.conventionalCommitScopes)
+			if !slices.Contains(scopes, scope) {
+				v.AddValidationError(fmt.Errorf("scope must be one of %v", scopes))
+			}
+		}
+		if maxSubjectLength > 0 && utf8.RuneCountInString(subject) > maxSubjectLength {
+			v.AddValidationError(fmt.Errorf("subject must be no more than %d characters", maxSubjectLength))
+		}
+		first, _ := utf8.DecodeRuneInString(subject)
+		if subjectCase == "lower" && unicode.IsUpper(first) {
+			v.AddValidationError(fmt.Errorf("subject must start with a lower case letter"))
+		}
+		if subjectCase == "sentence" && !unicode.IsUpper(first) {
+			v.AddValidationError(fmt.Errorf("subject must start with an upper case letter"))
+		}
+	}
+
+	if len(lines) > 1 && strings.TrimSpace(lines[1]) != "" {
+		v.AddValidationError(fmt.Errorf("body must be separated from the header by a blank line"))
+	}
+	for _, line := range lines[1:] {
+		if breakingChangeTokenRegexp.MatchString(line) && !breakingChangeFooterRegexp.MatchString(line) {
+			v.AddValidationError(fmt.Errorf("footer \"%s\" must be in the format of \"BREAKING CHANGE: description\"", line))
+		}
+	}
+}
+
+// defaultConventionalCommitTypes follows the types of @commitlint/config-conventional.
+var defaultConventionalCommitTypes = []string{"build", "chore", "ci", "docs", "feat", "fix", "perf", "refactor", "revert", "style", "test"}
+
+// conventionalCommitHeaderRegexp follows the header of Conventional Commits 1.0.0: "type(scope)!: subject".
+var conventionalCommitHeaderRegexp = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9-]*)(\(([^()\s][^()]*)\))?(!)?: (\S.*)$`)
+
+// breakingChangeTokenRegexp detects lines intended as the breaking change footer, even if malformed.
+var breakingChangeTokenRegexp = regexp.MustCompile(`(?i)^breaking[ -]changes?\b`)
+var breakingChangeFooterRegexp = regexp.MustCompile(`^BREAKING[ -]CHANGE: \S`)
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidator_conventionalCommitValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid", "feat: add a new rule", ""},
+		{"valid_scope", "fix(parser): handle empty input", ""},
+		{"valid_breaking", "refactor(api)!: drop the legacy flags", ""},
+		{"valid_body", "feat: add a new rule\n\nThe rule validates commit messages.", ""},
+		{"valid_footer", "feat!: change the output\n\nBREAKING CHANGE: the output format has changed", ""},
+		{"valid_footer_hyphen", "feat: change the output\n\nRefs: #123\nBREAKING-CHANGE: the output format has changed", ""},
+		{"valid_crlf", "fix: handle crlf\r\n\r\nBody.", ""},
+		{"empty", "", ""},
+		{"invalid_format", "add a new rule", "header must be in the format of \"type(scope)!: subject\""},
+		{"invalid_no_space", "feat:add a new rule", "header must be in the format of \"type(scope)!: subject\""},
+		{"invalid_empty_scope", "feat(): add a new rule", "header must be in the format of \"type(scope)!: subject\""},
+		{"invalid_type", "feature: add a new rule", "type must be one of [build chore ci docs feat fix perf refactor revert style test]"},
+		{"invalid_body", "feat: add a new rule\nThe rule validates commit messages.", "body must be separated from the header by a blank line"},
+		{"invalid_footer", "feat: change the output\n\nbreaking change: the output format has changed", "footer \"breaking change: the output format has changed\" must be in the format of \"BREAKING CHANGE: description\""},
+		{"invalid_footer_without_colon", "feat: change the output\n\nBREAKING CHANGE the output format has changed", "footer \"BREAKING CHANGE the output format has changed\" must be in the format of \"BREAKING CHANGE: description\""},
+		{"multiple_issues", "Feature: add\nBREAKING CHANGES: removed", "type must be one of [build chore ci docs feat fix perf refactor revert style test], body must be separated from the header by a blank line, footer \"BREAKING CHANGES: removed\" must be in the format of \"BREAKING CHANGE: description\""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.conventionalCommit = true
+		sut.conventionalCommitValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_conventionalCommitValidate_Options(t *testing.T) {
+	cases := []struct {
+		annotation       string
+		value            string
+		types            string
+		scopes           string
+		maxSubjectLength string
+		subjectCase      string
+		expected         string
+	}{
+		{"types_valid", "deps: bump cobra", "feat,fix,deps", "", "", "", ""},
+		{"types_invalid", "chore: bump cobra", "feat, fix", "", "", "", "type must be one of [feat fix]"},
+		{"scopes_valid", "fix(cli): handle flags", "", "cli,api", "", "", ""},
+		{"scopes_without_scope", "fix: handle flags", "", "cli,api", "", "", ""},
+		{"scopes_invalid", "fix(docs): handle flags", "", "cli,api", "", "", "scope must be one of [cli api]"},
+		{"max_subject_length_valid", "fix: 12345", "", "", "5", "", ""},
+		{"max_subject_length_invalid", "fix: 123456", "", "", "5", "", "subject must be no more than 5 characters"},
+		{"max_subject_length_multibyte", "fix: あいうえお", "", "", "5", "", ""},
+		{"subject_case_lower_valid", "fix: handle flags", "", "", "", "lower", ""},
+		{"subject_case_lower_invalid", "fix: Handle flags", "", "", "", "lower", "subject must start with a lower case letter"},
+		{"subject_case_sentence_valid", "fix: Handle flags", "", "", "", "sentence", ""},
+		{"subject_case_sentence_invalid", "fix: handle flags", "", "", "", "Sentence", "subject must start with an upper case letter"},
+		{"all_violations", "chore(docs): Update the readme", "feat,fix", "cli", "10", "lower", "type must be one of [feat fix], scope must be one of [cli], subject must be no more than 10 characters, subject must start with a lower case letter"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.conventionalCommitTypes = tc.types
+		sut.conventionalCommitScopes = tc.scopes
+		sut.conventionalCommitMaxSubjectLength = tc.maxSubjectLength
+		sut.conventionalCommitSubjectCase = tc.subjectCase
+		sut.conventionalCommitValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_conventionalCommitValidate_ArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation       string
+		maxSubjectLength string
+		subjectCase      string
+		expected         string
+	}{
+		{"invalid_max_subject_length", "ten", "", "Argument error: --conventional-commit-max-subject-length must be a positive integer number."},
+		{"zero_max_subject_length", "0", "", "Argument error: --conventional-commit-max-subject-length must be a positive integer number."},
+		{"invalid_subject_case", "", "camel", "Argument error: --conventional-commit-subject-case must be one of [lower sentence]."},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("feat: add a new rule")
+		sut.conventionalCommitMaxSubjectLength = tc.maxSubjectLength
+		sut.conventionalCommitSubjectCase = tc.subjectCase
+		sut.conventionalCommitValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
@@ -0,0 +1,440 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v *Validator) pemValidate() {
+	certOptions := v.certNotExpired || v.certMinValidity != "" || v.certHostname != ""
+	if v.pem == "" && !certOptions {
+		return
+	}
+
+	kind := strings.ToLower(v.pem)
+	if kind == "" {
+		kind = "certificate"
+	}
+	name, ok := pemKinds[kind]
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--pem must be one of [certificate private-key public-key csr]"))
+		return
+	}
+	if certOptions && kind != "certificate" {
+		v.AddArgumentError(fmt.Errorf("--cert-not-expired, --cert-min-validity and --cert-hostname require --pem certificate"))
+		return
+	}
+	var minValidity time.Duration
+	if v.certMinValidity != "" {
+		var err error
+		if minValidity, err = parseValidityDuration(v.certMinValidity); err != nil {
+			v.AddArgumentError(fmt.Errorf("--cert-min-validity must be a duration such as 720h or 30d"))
+			return
+		}
+	}
+	policy, ok := v.keyPolicy()
+	if !ok {
+		return
+	}
+	// private keys never appear in error messages, even if --mask-value isn't specified
+	if kind == "private-key" {
+		v.MaskValue()
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	blocks, ok := decodePEMBlocks(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid PEM-encoded %s", name))
+		return
+	}
+	if kind != "certificate" && len(blocks) != 1 {
+		v.AddValidationError(fmt.Errorf("must be a single PEM-encoded %s", name))
+		return
+	}
+	for _, block := range blocks {
+		if !slices.Contains(pemBlockTypes[kind], block.Type) {
+			v.AddValidationError(fmt.Errorf("must be a PEM-encoded %s, not \"%s\"", name, block.Type))
+			return
+		}
+	}
+
+	var key *keyInfo
+	var certificate *x509.Certificate
+	switch kind {
+	case "certificate":
+		for i, block := range blocks {
+			parsed, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				v.AddValidationError(fmt.Errorf("must be a valid PEM-encoded %s", name))
+				return
+			}
+			if i == 0 {
+				certificate = parsed
+			}
+		}
+		key, ok = publicKeyInfo(certificate.PublicKey)
+	case "private-key":
+		key, ok = parsePrivateKey(blocks[0])
+	case "public-key":
+		key, ok = parsePublicKey(blocks[0])
+	case "csr":
+		request, err := x509.ParseCertificateRequest(blocks[0].Bytes)
+		if err != nil || request.CheckSignature() != nil {
+			v.AddValidationError(fmt.Errorf("must be a valid PEM-encoded %s", name))
+			return
+		}
+		key, ok = publicKeyInfo(request.PublicKey)
+	}
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid PEM-encoded %s", name))
+		return
+	}
+
+	v.checkKeyPolicy(policy, key)
+	if certificate != nil {
+		v.checkCertificate(certificate, minValidity)
+	}
+}
+
+func (v *Validator) checkCertificate(certificate *x509.Certificate, minValidity time.Duration) {
+	now := time.Now()
+	if v.certNotExpired && now.After(certificate.NotAfter) {
+		v.AddValidationError(fmt.Errorf("certificate must not be expired"))
+	}
+	if v.certNotExpired && now.Before(certificate.NotBefore) {
+		v.AddValidationError(fmt.Errorf("certificate must already be valid"))
+	}
+	if v.certMinValidity != "" && certificate.NotAfter.Sub(now) < minValidity {
+		v.AddValidationError(fmt.Errorf("certificate must be valid for at least %s", v.certMinValidity))
+	}
+	if v.certHostname != "" && certificate.VerifyHostname(v.certHostname) != nil {
+		v.AddValidationError(fmt.Errorf("certificate must be valid for %s", v.certHostname))
+	}
+}
+
+func (v *Validator) sshPublicKeyValidate() {
+	if !v.sshPublicKey {
+		return
+	}
+	policy, ok := v.keyPolicy()
+	if !ok || v.UnmaskedValue == "" {
+		return
+	}
+
+	key, ok := parseSSHPublicKey(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid SSH public key"))
+		return
+	}
+	v.checkKeyPolicy(policy, key)
+}
+
+func (v *Validator) gpgFingerprintValidate() {
+	if !v.gpgFingerprint {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isGPGFingerprint, "must be a valid GPG fingerprint"))
+}
+
+func (v *Validator) keyPolicyValidate() {
+	if (v.keyAlgorithms != "" || v.minKeySize != "") && v.pem == "" && !v.sshPublicKey &&
+		!v.certNotExpired && v.certMinValidity == "" && v.certHostname == "" {
+		v.AddArgumentError(fmt.Errorf("--key-algorithms and --min-key-size require --pem or --ssh-public-key"))
+	}
+}
+
+// keyPolicy is the policy of --key-algorithms and --min-key-size.
+type keyPolicy struct {
+	algorithms []string
+	minSize    int
+}
+
+func (v *Validator) keyPolicy() (*keyPolicy, bool) {
+	if v.keyAlgorithms == "" && v.minKeySize == "" {
+		return nil, true
+	}
+	policy := &keyPolicy{}
+	if v.keyAlgorithms != "" {
+		policy.algorithms = splitList(strings.ToLower(v.keyAlgorithms))
+		for _, algorithm := range policy.algorithms {
+			if !slices.Contains([]string{"rsa", "ecdsa", "ed25519", "dsa"}, algorithm) {
+				v.AddArgumentError(fmt.Errorf("--key-algorithms must be a list of [rsa ecdsa ed25519 dsa]"))
+				return nil, false
+			}
+		}
+	}
+	if v.minKeySize != "" {
+		number, err := strconv.Atoi(v.minKeySize)
+		if err != nil || number < 1 {
+			v.AddArgumentError(fmt.Errorf("--min-key-size must be a positive integer number"))
+			return nil, false
+		}
+		policy.minSize = number
+	}
+	return policy, true
+}
+
+func (v *Validator) checkKeyPolicy(policy *keyPolicy, key *keyInfo) {
+	if policy == nil {
+		return
+	}
+	if key == nil {
+		v.AddValidationError(fmt.Errorf("key of an encrypted private key cannot be checked"))
+		return
+	}
+	if policy.algorithms != nil && !slices.Contains(policy.algorithms, key.algorithm) {
+		v.AddValidationError(fmt.Errorf("key algorithm must be one of %v", policy.algorithms))
+	}
+	if key.size < policy.minSize {
+		v.AddValidationError(fmt.Errorf("key size must be no less than %d bits", policy.minSize))
+	}
+}
+
+// keyInfo describes a public key, where the size is the modulus length for RSA and DSA,
+// and the curve size for ECDSA and Ed25519.
+type keyInfo struct {
+	algorithm string
+	size      int
+}
+
+func publicKeyInfo(key crypto.PublicKey) (*keyInfo, bool) {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return &keyInfo{"rsa", key.N.BitLen()}, true
+	case *ecdsa.PublicKey:
+		return &keyInfo{"ecdsa", key.Curve.Params().BitSize}, true
+	case ed25519.PublicKey:
+		return &keyInfo{"ed25519", 256}, true
+	default:
+		// DSA keys are not supported by crypto/x509 anymore
+		return nil, false
+	}
+}
+
+// decodePEMBlocks decodes all PEM blocks, and rejects any other text, which usually means the material is truncated.
+func decodePEMBlocks(value string) ([]*pem.Block, bool) {
+	var blocks []*pem.Block
+	rest := []byte(strings.TrimSpace(value))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, false
+		}
+		blocks = append(blocks, block)
+		rest = bytes.TrimSpace(rest)
+	}
+	return blocks, len(blocks) > 0
+}
+
+// parsePrivateKey returns nil keyInfo for encrypted private keys, since the key cannot be read without the passphrase.
+func parsePrivateKey(block *pem.Block) (*keyInfo, bool) {
+	if strings.Contains(block.Headers["Proc-Type"], "ENCRYPTED") {
+		return nil, true
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "OPENSSH PRIVATE KEY":
+		return parseOpenSSHPrivateKey(block.Bytes)
+	default:
+		return nil, true
+	}
+	if err != nil {
+		return nil, false
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, false
+	}
+	return publicKeyInfo(signer.Public())
+}
+
+func parsePublicKey(block *pem.Block) (*keyInfo, bool) {
+	var key any
+	var err error
+	if block.Type == "RSA PUBLIC KEY" {
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, false
+	}
+	return publicKeyInfo(key)
+}
+
+// parseOpenSSHPrivateKey reads the public key stored in the "openssh-key-v1" format,
+// which is readable even if the private key is encrypted.
+// See https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.key for details.
+func parseOpenSSHPrivateKey(data []byte) (*keyInfo, bool) {
+	rest, found := bytes.CutPrefix(data, []byte("openssh-key-v1\x00"))
+	if !found {
+		return nil, false
+	}
+	reader := &sshReader{rest}
+	reader.readString() // ciphername
+	reader.readString() // kdfname
+	reader.readString() // kdfoptions
+	if count, ok := reader.readUint32(); !ok || count != 1 {
+		return nil, false
+	}
+	publicKey, ok := reader.readString()
+	if !ok {
+		return nil, false
+	}
+	if _, ok = reader.readString(); !ok || len(reader.data) != 0 {
+		return nil, false
+	}
+	_, key, ok := parseSSHPublicKeyBlob(publicKey)
+	return key, ok
+}
+
+// parseSSHPublicKey parses the public key in the format of authorized_keys: "type base64 [comment]".
+func parseSSHPublicKey(value string) (*keyInfo, bool) {
+	fields := strings.Fields(value)
+	if len(fields) < 2 {
+		return nil, false
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, false
+	}
+	keyType, key, ok := parseSSHPublicKeyBlob(blob)
+	return key, ok && keyType == fields[0]
+}
+
+// parseSSHPublicKeyBlob parses the wire format of SSH public keys defined in RFC 4253, RFC 5656 and RFC 8709.
+func parseSSHPublicKeyBlob(blob []byte) (string, *keyInfo, bool) {
+	reader := &sshReader{blob}
+	keyType, ok := reader.readString()
+	if !ok {
+		return "", nil, false
+	}
+
+	var key *keyInfo
+	switch string(keyType) {
+	case "ssh-rsa":
+		exponent, _ := reader.readString()
+		modulus, ok := reader.readString()
+		if !ok || len(exponent) == 0 {
+			return "", nil, false
+		}
+		key = &keyInfo{"rsa", new(big.Int).SetBytes(modulus).BitLen()}
+	case "ssh-dss":
+		p, _ := reader.readString()
+		reader.readString() // q
+		reader.readString() // g
+		if _, ok := reader.readString(); !ok {
+			return "", nil, false
+		}
+		key = &keyInfo{"dsa", new(big.Int).SetBytes(p).BitLen()}
+	case "ssh-ed25519", "sk-ssh-ed25519@openssh.com":
+		if publicKey, ok := reader.readString(); !ok || len(publicKey) != ed25519.PublicKeySize {
+			return "", nil, false
+		}
+		key = &keyInfo{"ed25519", 256}
+	case "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521", "sk-ecdsa-sha2-nistp256@openssh.com":
+		curve, _ := reader.readString()
+		point, ok := reader.readString()
+		size := map[string]int{"nistp256": 256, "nistp384": 384, "nistp521": 521}[string(curve)]
+		if !ok || !strings.Contains(string(keyType), string(curve)) || len(point) != 1+2*((size+7)/8) || point[0] != 4 {
+			return "", nil, false
+		}
+		key = &keyInfo{"ecdsa", size}
+	default:
+		return "", nil, false
+	}
+
+	// security keys have the application, such as "ssh:"
+	if strings.HasPrefix(string(keyType), "sk-") {
+		if _, ok := reader.readString(); !ok {
+			return "", nil, false
+		}
+	}
+	return string(keyType), key, len(reader.data) == 0
+}
+
+type sshReader struct {
+	data []byte
+}
+
+func (r *sshReader) readUint32() (uint32, bool) {
+	if len(r.data) < 4 {
+		return 0, false
+	}
+	value := binary.BigEndian.Uint32(r.data)
+	r.data = r.data[4:]
+	return value, true
+}
+
+func (r *sshReader) readString() ([]byte, bool) {
+	length, ok := r.readUint32()
+	if !ok || uint32(len(r.data)) < length {
+		r.data = nil
+		return nil, false
+	}
+	value := r.data[:length]
+	r.data = r.data[length:]
+	return value, true
+}
+
+// parseValidityDuration parses durations of time.ParseDuration, and days such as "30d".
+func parseValidityDuration(value string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(value, "d"); found {
+		number, err := strconv.Atoi(days)
+		if err != nil || number < 0 {
+			return 0, fmt.Errorf("invalid duration: %s", value)
+		}
+		return time.Duration(number) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// isGPGFingerprint accepts v4 (40 hex digits) and v5/v6 (64 hex digits) fingerprints,
+// either compact with an optional "0x" prefix, or in groups of four as printed by gpg.
+func isGPGFingerprint(value string) bool {
+	return gpgFingerprintRegexp.MatchString(value) || gpgGroupedFingerprintRegexp.MatchString(value)
+}
+
+var pemKinds = map[string]string{
+	"certificate": "certificate",
+	"private-key": "private key",
+	"public-key":  "public key",
+	"csr":         "certificate signing request",
+}
+
+var pemBlockTypes = map[string][]string{
+	"certificate": {"CERTIFICATE"},
+	"private-key": {"PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY", "ENCRYPTED PRIVATE KEY", "OPENSSH PRIVATE KEY"},
+	"public-key":  {"PUBLIC KEY", "RSA PUBLIC KEY"},
+	"csr":         {"CERTIFICATE REQUEST", "NEW CERTIFICATE REQUEST"},
+}
+
+var gpgFingerprintRegexp = regexp.MustCompile(`^(0x)?([0-9A-Fa-f]{40}|[0-9A-Fa-f]{64})$`)
+var gpgGroupedFingerprintRegexp = regexp.MustCompile(`^[0-9A-Fa-f]{4}((?: {1,2}[0-9A-Fa-f]{4}){9}|(?: {1,2}[0-9A-Fa-f]{4}){15})$`)
@@ -0,0 +1,315 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidator_pemValidate(t *testing.T) {
+	ecKey := newTestECDSAKey(t)
+	certificate := newTestCertificate(t, ecKey, time.Now().Add(-time.Hour), time.Now().Add(90*24*time.Hour))
+	cases := []struct {
+		annotation string
+		value      string
+		kind       string
+		expected   string
+	}{
+		{"certificate", certificate, "certificate", ""},
+		{"certificate_chain", certificate + certificate, "certificate", ""},
+		{"certificate_truncated", certificate[:len(certificate)/2], "certificate", "must be a valid PEM-encoded certificate"},
+		{"certificate_broken", pemEncodeForTest("CERTIFICATE", []byte("broken")), "certificate", "must be a valid PEM-encoded certificate"},
+		{"certificate_wrong_type", pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, ecKey.Public())), "Certificate", "must be a PEM-encoded certificate, not \"PUBLIC KEY\""},
+		{"public_key", pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, ecKey.Public())), "public-key", ""},
+		{"public_key_multiple", strings.Repeat(pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, ecKey.Public())), 2), "public-key", "must be a single PEM-encoded public key"},
+		{"csr", newTestCSR(t, ecKey), "csr", ""},
+		{"csr_wrong_type", certificate, "csr", "must be a PEM-encoded certificate signing request, not \"CERTIFICATE\""},
+		{"not_pem", "not a certificate", "certificate", "must be a valid PEM-encoded certificate"},
+		{"empty", "", "certificate", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.pem = tc.kind
+		sut.pemValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_pemValidate_PrivateKey(t *testing.T) {
+	ecKey := newTestECDSAKey(t)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ec, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"pkcs8", pemEncodeForTest("PRIVATE KEY", pkcs8), ""},
+		{"ec", pemEncodeForTest("EC PRIVATE KEY", ec), ""},
+		{"encrypted", pemEncodeForTest("ENCRYPTED PRIVATE KEY", []byte("encrypted")), ""},
+		{"openssh", pemEncodeForTest("OPENSSH PRIVATE KEY", newTestOpenSSHPrivateKey(sshEd25519BlobForTest(edKey.Public().(ed25519.PublicKey)))), ""},
+		{"openssh_truncated", pemEncodeForTest("OPENSSH PRIVATE KEY", []byte("openssh-key-v1\x00")), "must be a valid PEM-encoded private key"},
+		{"broken", pemEncodeForTest("RSA PRIVATE KEY", []byte("broken")), "must be a valid PEM-encoded private key"},
+		{"wrong_type", pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, ecKey.Public())), "must be a PEM-encoded private key, not \"PUBLIC KEY\""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.pem = "private-key"
+		sut.pemValidate()
+		// private keys are always masked
+		assert(t, tc.expected, sut.Errors, MaskedValue, tc.annotation)
+	}
+}
+
+func TestValidator_pemValidate_Certificate(t *testing.T) {
+	ecKey := newTestECDSAKey(t)
+	now := time.Now()
+	cases := []struct {
+		annotation  string
+		notBefore   time.Time
+		notAfter    time.Time
+		notExpired  bool
+		minValidity string
+		hostname    string
+		expected    string
+	}{
+		{"not_expired_valid", now.Add(-time.Hour), now.Add(time.Hour), true, "", "", ""},
+		{"not_expired_invalid", now.Add(-2 * time.Hour), now.Add(-time.Hour), true, "", "", "certificate must not be expired"},
+		{"not_yet_valid", now.Add(time.Hour), now.Add(2 * time.Hour), true, "", "", "certificate must already be valid"},
+		{"min_validity_valid", now, now.Add(31 * 24 * time.Hour), false, "30d", "", ""},
+		{"min_validity_invalid", now, now.Add(29 * 24 * time.Hour), false, "30d", "", "certificate must be valid for at least 30d"},
+		{"min_validity_hours", now, now.Add(time.Hour), false, "2h", "", "certificate must be valid for at least 2h"},
+		{"hostname_valid", now, now.Add(time.Hour), false, "", "www.example.com", ""},
+		{"hostname_invalid", now, now.Add(time.Hour), false, "", "example.org", "certificate must be valid for example.org"},
+		{"multiple_issues", now.Add(-2 * time.Hour), now.Add(-time.Hour), true, "1d", "example.org", "certificate must not be expired, certificate must be valid for at least 1d, certificate must be valid for example.org"},
+	}
+
+	for _, tc := range cases {
+		value := newTestCertificate(t, ecKey, tc.notBefore, tc.notAfter)
+		sut := newValidatorSut(value)
+		sut.certNotExpired = tc.notExpired
+		sut.certMinValidity = tc.minValidity
+		sut.certHostname = tc.hostname
+		sut.pemValidate()
+		assert(t, tc.expected, sut.Errors, value, tc.annotation)
+	}
+}
+
+func TestValidator_pemValidate_KeyPolicy(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey := newTestECDSAKey(t)
+	rsaPublicKey := pemEncodeForTest("RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey))
+	ecPublicKey := pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, ecKey.Public()))
+	cases := []struct {
+		annotation string
+		value      string
+		kind       string
+		algorithms string
+		minSize    string
+		expected   string
+	}{
+		{"rsa_valid", rsaPublicKey, "public-key", "rsa", "2048", ""},
+		{"rsa_too_small", rsaPublicKey, "public-key", "", "3072", "key size must be no less than 3072 bits"},
+		{"ecdsa_valid", ecPublicKey, "public-key", "ecdsa,ed25519", "256", ""},
+		{"ecdsa_not_allowed", ecPublicKey, "public-key", "RSA", "", "key algorithm must be one of [rsa]"},
+		{"certificate", newTestCertificate(t, ecKey, time.Now(), time.Now().Add(time.Hour)), "certificate", "rsa", "384", "key algorithm must be one of [rsa], key size must be no less than 384 bits"},
+		{"csr", newTestCSR(t, ecKey), "csr", "ecdsa", "256", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.pem = tc.kind
+		sut.keyAlgorithms = tc.algorithms
+		sut.minKeySize = tc.minSize
+		sut.pemValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_sshPublicKeyValidate(t *testing.T) {
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey := newTestECDSAKey(t)
+	ed25519Blob := sshEd25519BlobForTest(edKey)
+	ecdhKey, err := ecKey.PublicKey.ECDH()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaBlob := sshBlobForTest([]byte("ecdsa-sha2-nistp256"), []byte("nistp256"), ecdhKey.Bytes())
+	rsaBlob := sshBlobForTest([]byte("ssh-rsa"), []byte{1, 0, 1}, append([]byte{0}, new(big.Int).Lsh(big.NewInt(1), 1023).Bytes()...))
+	cases := []struct {
+		annotation string
+		value      string
+		algorithms string
+		minSize    string
+		expected   string
+	}{
+		{"ed25519", "ssh-ed25519 " + base64.StdEncoding.EncodeToString(ed25519Blob) + " user@example.com", "", "", ""},
+		{"ecdsa", "ecdsa-sha2-nistp256 " + base64.StdEncoding.EncodeToString(ecdsaBlob), "ecdsa", "256", ""},
+		{"rsa_too_small", "ssh-rsa " + base64.StdEncoding.EncodeToString(rsaBlob), "rsa", "2048", "key size must be no less than 2048 bits"},
+		{"algorithm_not_allowed", "ssh-ed25519 " + base64.StdEncoding.EncodeToString(ed25519Blob), "rsa", "", "key algorithm must be one of [rsa]"},
+		{"type_mismatch", "ssh-rsa " + base64.StdEncoding.EncodeToString(ed25519Blob), "", "", "must be a valid SSH public key"},
+		{"truncated", "ssh-ed25519 " + base64.StdEncoding.EncodeToString(ed25519Blob[:len(ed25519Blob)-4]), "", "", "must be a valid SSH public key"},
+		{"invalid_base64", "ssh-ed25519 AAAA@@@@", "", "", "must be a valid SSH public key"},
+		{"missing_key", "ssh-ed25519", "", "", "must be a valid SSH public key"},
+		{"empty", "", "", "", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.sshPublicKey = true
+		sut.keyAlgorithms = tc.algorithms
+		sut.minKeySize = tc.minSize
+		sut.sshPublicKeyValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_gpgFingerprintValidate(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"0123456789ABCDEF0123456789ABCDEF01234567", ""},
+		{"0x0123456789abcdef0123456789abcdef01234567", ""},
+		{"0123 4567 89AB CDEF 0123  4567 89AB CDEF 0123 4567", ""},
+		{strings.Repeat("0123456789ABCDEF", 4), ""},
+		{"0123456789ABCDEF", "must be a valid GPG fingerprint"},
+		{"0123456789ABCDEF0123456789ABCDEF0123456G", "must be a valid GPG fingerprint"},
+		{"0123 4567 89AB CDEF 0123 4567 89AB CDEF 0123", "must be a valid GPG fingerprint"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.gpgFingerprint = true
+		sut.gpgFingerprintValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_pemValidate_ArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation  string
+		kind        string
+		minValidity string
+		algorithms  string
+		minSize     string
+		expected    string
+	}{
+		{"invalid_kind", "key", "", "", "", "Argument error: --pem must be one of [certificate private-key public-key csr]."},
+		{"cert_option_with_other_kind", "public-key", "30d", "", "", "Argument error: --cert-not-expired, --cert-min-validity and --cert-hostname require --pem certificate."},
+		{"invalid_min_validity", "", "1month", "", "", "Argument error: --cert-min-validity must be a duration such as 720h or 30d."},
+		{"invalid_algorithm", "certificate", "", "dh", "", "Argument error: --key-algorithms must be a list of [rsa ecdsa ed25519 dsa]."},
+		{"invalid_min_size", "certificate", "", "", "-1", "Argument error: --min-key-size must be a positive integer number."},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("")
+		sut.pem = tc.kind
+		sut.certMinValidity = tc.minValidity
+		sut.keyAlgorithms = tc.algorithms
+		sut.minKeySize = tc.minSize
+		sut.pemValidate()
+		sut.keyPolicyValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
+
+func newTestECDSAKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newTestCertificate(t *testing.T, key crypto.Signer, notBefore time.Time, notAfter time.Time) string {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		DNSNames:     []string{"example.com", "*.example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pemEncodeForTest("CERTIFICATE", der)
+}
+
+func newTestCSR(t *testing.T, key crypto.Signer) string {
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "example.com"}}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pemEncodeForTest("CERTIFICATE REQUEST", der)
+}
+
+// newTestOpenSSHPrivateKey builds an unencrypted "openssh-key-v1" key with a dummy private section.
+func newTestOpenSSHPrivateKey(publicKey []byte) []byte {
+	header := sshBlobForTest([]byte("none"), []byte("none"), []byte{})
+	count := binary.BigEndian.AppendUint32(nil, 1)
+	body := sshBlobForTest(publicKey, []byte("private"))
+	return append(append(append([]byte("openssh-key-v1\x00"), header...), count...), body...)
+}
+
+func sshEd25519BlobForTest(key ed25519.PublicKey) []byte {
+	return sshBlobForTest([]byte("ssh-ed25519"), key)
+}
+
+func sshBlobForTest(values ...[]byte) []byte {
+	var blob []byte
+	for _, value := range values {
+		blob = binary.BigEndian.AppendUint32(blob, uint32(len(value)))
+		blob = append(blob, value...)
+	}
+	return blob
+}
+
+func marshalPKIXForTest(t *testing.T, key crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func pemEncodeForTest(blockType string, bytes []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}))
+}
@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"golang.org/x/net/idna"
+)
+
+// isLooseEmail reports whether value is an email address accepted by is.EmailFormat, as --email has done
+// before the modes were added. It accepts non-ASCII characters regardless of idn, which only converts the domain.
+func isLooseEmail(value string, _ bool) bool {
+	return is.EmailFormat.Validate(value) == nil
+}
+
+// isRFC5322Email reports whether value is a bare addr-spec defined in RFC 5322.
+// Non-ASCII local parts are accepted only when idn is true, as permitted by RFC 6532.
+func isRFC5322Email(value string, idn bool) bool {
+	local, domain := splitEmail(value)
+	if !isASCII(domain) || (!idn && !isASCII(local)) {
+		return false
+	}
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" {
+		return false
+	}
+	parsedLocal, parsedDomain := splitEmail(address.Address)
+	quoted := len(local) > 1 && strings.HasPrefix(local, `"`) && strings.HasSuffix(local, `"`)
+	if parsedDomain != domain || (parsedLocal != local && !quoted) {
+		return false
+	}
+	if literal, ok := strings.CutPrefix(domain, "["); ok {
+		return isIP(strings.TrimPrefix(strings.TrimSuffix(literal, "]"), "IPv6:"))
+	}
+	return isHostname(domain)
+}
+
+// isHTML5Email reports whether value is a valid email address defined in the HTML Living Standard.
+func isHTML5Email(value string, idn bool) bool {
+	if idn {
+		return html5IDNEmailRegexp.MatchString(value)
+	}
+	return html5EmailRegexp.MatchString(value)
+}
+
+func toASCIIEmail(value string) (string, error) {
+	local, domain := splitEmail(value)
+	ascii, err := toASCIIDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	return local + "@" + ascii, nil
+}
+
+func toASCIIDomain(value string) (string, error) {
+	return idna.Lookup.ToASCII(value)
+}
+
+func toASCIIDomains(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if ascii, err := toASCIIDomain(strings.TrimPrefix(value, "*.")); err == nil && ascii != "" {
+			if strings.HasPrefix(value, "*.") {
+				ascii = "*." + ascii
+			}
+			value = ascii
+		}
+		result = append(result, value)
+	}
+	return result
+}
+
+func splitEmail(value string) (local string, domain string) {
+	i := strings.LastIndex(value, "@")
+	if i < 0 {
+		return value, ""
+	}
+	return value[:i], value[i+1:]
+}
+
+func isASCII(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+const html5EmailDomain = `@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`
+
+var html5EmailRegexp = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+" + html5EmailDomain)
+var html5IDNEmailRegexp = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~\\-\\x{80}-\\x{10FFFF}]+" + html5EmailDomain)
@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestValidator_domainValidate_IDN(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		idn        bool
+		expected   string
+	}{
+		{"ascii", "example.com", true, ""},
+		{"punycode", "xn--r8jz45g.jp", true, ""},
+		{"unicode", "例え.jp", true, ""},
+		{"unicode_without_idn", "例え.jp", false, "must be a valid domain"},
+		{"invalid_label", "-example.com", true, "must be a valid domain"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.domain = true
+		sut.idn = tc.idn
+		sut.domainValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_emailValidate_Mode(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		mode       string
+		idn        bool
+		expected   string
+	}{
+		{"loose_valid", "foo@example.com", "loose", false, ""},
+		{"loose_baseline1", "用户@example.com", "loose", false, ""},
+		{"loose_baseline2", "a@bücher.de", "", false, ""},
+		{"loose_idn_valid1", "用户@example.com", "loose", true, ""},
+		{"loose_idn_valid2", "a@bücher.de", "", true, ""},
+		{"rfc5322_valid1", "foo.bar@example.com", "rfc5322", false, ""},
+		{"rfc5322_valid2", `"foo bar"@example.com`, "rfc5322", false, ""},
+		{"rfc5322_valid3", "foo@[192.0.2.1]", "rfc5322", false, ""},
+		{"rfc5322_invalid1", "Foo <foo@example.com>", "rfc5322", false, "must be a valid email address"},
+		{"rfc5322_invalid2", "foo..bar@example.com", "rfc5322", false, "must be a valid email address"},
+		{"rfc5322_invalid3", "foo@-example.com", "rfc5322", false, "must be a valid email address"},
+		{"rfc5322_invalid4", "föö@example.com", "rfc5322", false, "must be a valid email address"},
+		{"rfc5322_idn_valid", "föö@例え.jp", "rfc5322", true, ""},
+		{"html5_valid1", "foo+bar@example.com", "html5", false, ""},
+		{"html5_valid2", "foo@localhost", "html5", false, ""},
+		{"html5_invalid1", `"foo bar"@example.com`, "html5", false, "must be a valid email address"},
+		{"html5_invalid2", "foo@例え.jp", "html5", false, "must be a valid email address"},
+		{"html5_idn_valid", "föö@例え.jp", "html5", true, ""},
+		{"idn_invalid", "foo@exa_mple.com", "loose", true, "must be a valid email address"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.email = true
+		sut.emailMode = tc.mode
+		sut.idn = tc.idn
+		sut.emailValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_emailValidate_Policy(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		domains    string
+		forbidPlus bool
+		expected   string
+	}{
+		{"domains_valid1", "foo@example.com", "example.com,example.org", false, ""},
+		{"domains_valid2", "foo@mail.Example.com", "*.example.com", false, ""},
+		{"domains_invalid", "foo@example.net", "example.com,*.example.com", false, "domain must match one of [example.com *.example.com]"},
+		{"forbid_plus_valid", "foo@example.com", "", true, ""},
+		{"forbid_plus_invalid", "foo+bar@example.com", "", true, "must not use plus addressing"},
+		{"multiple_invalid", "foo+bar@example.net", "example.com", true, "domain must match one of [example.com], must not use plus addressing"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.email = true
+		sut.emailDomains = tc.domains
+		sut.emailForbidPlus = tc.forbidPlus
+		sut.emailValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_emailValidate_ArgumentError(t *testing.T) {
+	sut := newValidatorSut("foo@evilexample.com")
+	sut.email = true
+	sut.emailDomains = "*example.com"
+	sut.emailValidate()
+
+	expected := "Argument error: --email-domains \"*example.com\" is not a valid host pattern, use *.example.com for subdomains."
+	if sut.Errors.Error() != expected {
+		t.Errorf(formatMessage(expected, sut.Errors, sut.UnmaskedValue, sut.emailDomains))
+	}
+}
@@ -9,13 +9,31 @@ type Errors struct {
 	value       InvalidValue
 	validations []error
 	arguments   []error
+	redactions  []string
+	mask        bool
 }
 
 type InvalidValue interface {
+	Unmasked() string
 	Masked() string
 	Name() string
 }
 
+// detailedError is the validation error with the detail showing a part of the value, such as the offending character.
+// The detail is left out of error messages when the value is masked or redacted, so that the secret doesn't leak.
+type detailedError struct {
+	issue  string
+	detail string
+}
+
+func newDetailedError(issue string, detail string) error {
+	return &detailedError{issue: issue, detail: detail}
+}
+
+func (d *detailedError) Error() string {
+	return d.issue + ", but " + d.detail
+}
+
 func (e *Errors) AddValidationError(err error) {
 	e.validations = append(e.validations, err)
 }
@@ -24,6 +42,18 @@ func (e *Errors) AddArgumentError(err error) {
 	e.arguments = append(e.arguments, err)
 }
 
+// Redact replaces the secret in the value of error messages with the mask, even when the value isn't masked.
+func (e *Errors) Redact(secret string) {
+	if secret != "" {
+		e.redactions = append(e.redactions, secret)
+	}
+}
+
+// MaskValue masks the value in error messages, even when the value isn't masked.
+func (e *Errors) MaskValue() {
+	e.mask = true
+}
+
 func (e *Errors) HasError() bool {
 	return e.hasValidations() || e.hasArguments()
 }
@@ -53,13 +83,30 @@ func (e *Errors) joinValidationError() string {
 		return ""
 	}
 
+	value := e.displayValue()
+	hidden := value != e.value.Unmasked()
 	issues := make([]string, 0, len(e.validations))
 	for _, err := range e.validations {
+		if detailed, ok := err.(*detailedError); ok && hidden {
+			issues = append(issues, detailed.issue)
+			continue
+		}
 		issues = append(issues, err.Error())
 	}
 
 	return fmt.Sprintf("Validation error: The specified %s \"%s\" is invalid. Issues: %s",
-		e.value.Name(), e.value.Masked(), strings.Join(issues, ", "))
+		e.value.Name(), value, strings.Join(issues, ", "))
+}
+
+func (e *Errors) displayValue() string {
+	if e.mask {
+		return MaskedValue
+	}
+	value := e.value.Masked()
+	for _, secret := range e.redactions {
+		value = strings.ReplaceAll(value, secret, MaskedValue)
+	}
+	return value
 }
 
 func (e *Errors) joinArgumentError() string {
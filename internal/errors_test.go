@@ -66,3 +66,42 @@ func TestErrors_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestErrors_Redact(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      *Value
+		secrets    []string
+		expected   string
+	}{
+		{"redacted", &Value{raw: "user:secret@host"}, []string{"secret"}, "Validation error: The specified value \"user:***@host\" is invalid. Issues: must not contain secret."},
+		{"multiple", &Value{raw: "a:b:c"}, []string{"a", "c"}, "Validation error: The specified value \"***:b:***\" is invalid. Issues: must not contain secret."},
+		{"empty", &Value{raw: "pseudo"}, []string{""}, "Validation error: The specified value \"pseudo\" is invalid. Issues: must not contain secret."},
+		{"masked", &Value{raw: "user:secret@host", mask: true}, []string{"secret"}, "Validation error: The specified value \"***\" is invalid. Issues: must not contain secret."},
+	}
+
+	for _, tc := range cases {
+		sut := &Errors{value: tc.value}
+		for _, secret := range tc.secrets {
+			sut.Redact(secret)
+		}
+		sut.AddValidationError(fmt.Errorf("must not contain secret"))
+		actual := sut.Error()
+
+		format := "\n annotation: %s\n expected:   %v\n actual:     %v"
+		if tc.expected != actual {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, actual))
+		}
+	}
+}
+
+func TestErrors_MaskValue(t *testing.T) {
+	sut := &Errors{value: &Value{raw: "secret"}}
+	sut.MaskValue()
+	sut.AddValidationError(fmt.Errorf("must be valid"))
+
+	expected := "Validation error: The specified value \"***\" is invalid. Issues: must be valid."
+	if sut.Error() != expected {
+		t.Errorf(fmt.Sprintf("\n expected: %s\n actual:   %s", expected, sut.Error()))
+	}
+}
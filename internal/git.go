@@ -0,0 +1,264 @@
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+func (v *Validator) gitRefNameValidate() {
+	if !v.gitRefName || v.UnmaskedValue == "" {
+		return
+	}
+	if !isGitRefName(v.UnmaskedValue) {
+		v.AddValidationError(fmt.Errorf("must be a valid git ref name"))
+	}
+}
+
+func (v *Validator) gitBranchPatternsValidate() {
+	if v.gitBranchPatterns == "" {
+		return
+	}
+
+	patterns := splitList(v.gitBranchPatterns)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			v.AddArgumentError(fmt.Errorf("--git-branch-patterns \"%s\" is not a valid glob pattern", pattern))
+			return
+		}
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, v.UnmaskedValue); matched {
+			return
+		}
+	}
+	v.AddValidationError(fmt.Errorf("must match one of %v", patterns))
+}
+
+func (v *Validator) commitSHAValidate() {
+	if v.commitSHA == "" {
+		return
+	}
+
+	kinds := map[string]func(length int) bool{
+		"sha1":   func(length int) bool { return length == 40 },
+		"sha256": func(length int) bool { return length == 64 },
+		"full":   func(length int) bool { return length == 40 || length == 64 },
+		"short":  func(length int) bool { return 7 <= length && length <= 64 },
+	}
+
+	kind := strings.ToLower(v.commitSHA)
+	validLength, ok := kinds[kind]
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--commit-sha must be one of [sha1 sha256 full short]"))
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+	if !validLength(len(v.UnmaskedValue)) || !lowerHexRegexp.MatchString(v.UnmaskedValue) {
+		v.AddValidationError(fmt.Errorf("must be a valid %s commit SHA", kind))
+	}
+}
+
+func (v *Validator) gitTagAbsentValidate() {
+	if !v.gitTagAbsent {
+		return
+	}
+
+	repository, err := findGitRepositoryFromWorkingDir()
+	if err != nil {
+		v.AddArgumentError(fmt.Errorf("--git-tag-absent %s", err))
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+	exists, err := repository.hasRef("refs/tags/" + v.UnmaskedValue)
+	if err != nil {
+		v.AddArgumentError(fmt.Errorf("--git-tag-absent %s", err))
+		return
+	}
+	if exists {
+		v.AddValidationError(fmt.Errorf("tag must not already exist"))
+	}
+}
+
+func (v *Validator) gitRefExistsValidate() {
+	if !v.gitRefExists {
+		return
+	}
+
+	repository, err := findGitRepositoryFromWorkingDir()
+	if err != nil {
+		v.AddArgumentError(fmt.Errorf("--git-ref-exists %s", err))
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+	exists, err := repository.resolveRef(v.UnmaskedValue)
+	if err != nil {
+		v.AddArgumentError(fmt.Errorf("--git-ref-exists %s", err))
+		return
+	}
+	if !exists {
+		v.AddValidationError(fmt.Errorf("ref must exist in the repository"))
+	}
+}
+
+// isGitRefName implements the rules of "git check-ref-format --allow-onelevel".
+func isGitRefName(value string) bool {
+	if value == "" || value == "@" || strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") || strings.HasSuffix(value, ".") {
+		return false
+	}
+	if strings.Contains(value, "..") || strings.Contains(value, "//") || strings.Contains(value, "@{") {
+		return false
+	}
+	if strings.ContainsFunc(value, func(r rune) bool { return r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) }) {
+		return false
+	}
+	for _, component := range strings.Split(value, "/") {
+		if strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+			return false
+		}
+	}
+	return true
+}
+
+// gitRepository reads refs directly from the .git directory without running git.
+type gitRepository struct {
+	gitDir    string
+	commonDir string
+}
+
+func findGitRepositoryFromWorkingDir() (*gitRepository, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	return findGitRepository(dir)
+}
+
+// findGitRepository searches dir and its parents for a .git directory,
+// or a .git file pointing to the git directory of a linked worktree.
+func findGitRepository(dir string) (*gitRepository, error) {
+	for {
+		dotGit := filepath.Join(dir, ".git")
+		if info, err := os.Stat(dotGit); err == nil {
+			gitDir := dotGit
+			if !info.IsDir() {
+				if gitDir, err = readGitDirFile(dotGit); err != nil {
+					return nil, err
+				}
+			}
+			return newGitRepository(gitDir)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, errors.New("requires a git repository in the working directory")
+		}
+		dir = parent
+	}
+}
+
+func readGitDirFile(file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	gitDir, found := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir: ")
+	if !found {
+		return "", fmt.Errorf("cannot read the git directory from %s", file)
+	}
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(filepath.Dir(file), gitDir)
+	}
+	return gitDir, nil
+}
+
+func newGitRepository(gitDir string) (*gitRepository, error) {
+	repository := &gitRepository{gitDir: gitDir, commonDir: gitDir}
+	if content, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir := strings.TrimSpace(string(content))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+		repository.commonDir = commonDir
+	}
+	if _, err := os.Stat(filepath.Join(repository.commonDir, "reftable")); err == nil {
+		return nil, errors.New("does not support the reftable format")
+	}
+	return repository, nil
+}
+
+// resolveRef reports whether name exists, following the same lookup order as "git rev-parse".
+func (r *gitRepository) resolveRef(name string) (bool, error) {
+	if !isGitRefName(name) && name != "@" {
+		return false, nil
+	}
+	if name == "@" {
+		name = "HEAD"
+	}
+
+	candidates := []string{
+		name,
+		"refs/" + name,
+		"refs/tags/" + name,
+		"refs/heads/" + name,
+		"refs/remotes/" + name,
+		"refs/remotes/" + name + "/HEAD",
+	}
+	for _, candidate := range candidates {
+		exists, err := r.hasRef(candidate)
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+	return false, nil
+}
+
+// hasRef reports whether the fully qualified ref exists as a loose ref or in packed-refs.
+// Names outside "refs/" are accepted only when they look like pseudo refs, such as HEAD.
+func (r *gitRepository) hasRef(name string) (bool, error) {
+	if !isGitRefName(name) || (!strings.HasPrefix(name, "refs/") && !gitPseudoRefRegexp.MatchString(name)) {
+		return false, nil
+	}
+
+	for _, dir := range []string{r.gitDir, r.commonDir} {
+		if info, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); err == nil && !info.IsDir() {
+			return true, nil
+		}
+	}
+
+	file, err := os.Open(filepath.Join(r.commonDir, "packed-refs"))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.HasPrefix(line, "^") {
+			continue
+		}
+		if _, ref, found := strings.Cut(line, " "); found && ref == name {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+var gitPseudoRefRegexp = regexp.MustCompile(`^[A-Z][A-Z_]*$`)
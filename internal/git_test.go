@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidator_gitRefNameValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "main", ""},
+		{"valid2", "feature/add-rule", ""},
+		{"valid3", "v1.2.3", ""},
+		{"valid4", "refs/heads/release/1.0", ""},
+		{"invalid1", "feature/.hidden", "must be a valid git ref name"},
+		{"invalid2", "feature/branch.lock", "must be a valid git ref name"},
+		{"invalid3", "feature..branch", "must be a valid git ref name"},
+		{"invalid4", "feature branch", "must be a valid git ref name"},
+		{"invalid5", "feature~1", "must be a valid git ref name"},
+		{"invalid6", "feature//branch", "must be a valid git ref name"},
+		{"invalid7", "/feature", "must be a valid git ref name"},
+		{"invalid8", "feature.", "must be a valid git ref name"},
+		{"invalid9", "feature@{1}", "must be a valid git ref name"},
+		{"invalid10", "@", "must be a valid git ref name"},
+		{"invalid11", "feature\\branch", "must be a valid git ref name"},
+		{"invalid12", "feature*", "must be a valid git ref name"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.gitRefName = true
+		sut.gitRefNameValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_gitBranchPatternsValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "feature/add-rule", "feature/*,release/*", ""},
+		{"valid2", "release/1.2", "feature/*,release/*", ""},
+		{"valid3", "main", "main", ""},
+		{"empty", "", "feature/*", ""},
+		{"invalid1", "feature/nested/branch", "feature/*", "must match one of [feature/*]"},
+		{"invalid2", "hotfix/bug", "feature/*,release/*", "must match one of [feature/* release/*]"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.gitBranchPatterns = tc.argument
+		sut.gitBranchPatternsValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_commitSHAValidate(t *testing.T) {
+	sha1 := "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"sha1_valid", sha1, "sha1", ""},
+		{"sha1_invalid", sha1[:39], "sha1", "must be a valid sha1 commit SHA"},
+		{"sha256_valid", sha256Hex, "sha256", ""},
+		{"sha256_invalid", sha1, "sha256", "must be a valid sha256 commit SHA"},
+		{"full_valid1", sha1, "full", ""},
+		{"full_valid2", sha256Hex, "full", ""},
+		{"full_invalid", sha1[:7], "full", "must be a valid full commit SHA"},
+		{"short_valid", sha1[:7], "short", ""},
+		{"short_invalid1", sha1[:6], "short", "must be a valid short commit SHA"},
+		{"short_invalid2", "DA39A3E", "short", "must be a valid short commit SHA"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.commitSHA = tc.argument
+		sut.commitSHAValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestGitRepository_resolveRef(t *testing.T) {
+	root := t.TempDir()
+	gitDir := filepath.Join(root, "repo", ".git")
+	writeTestFile(t, filepath.Join(gitDir, "HEAD"), "ref: refs/heads/main\n")
+	writeTestFile(t, filepath.Join(gitDir, "config"), "")
+	writeTestFile(t, filepath.Join(gitDir, "refs", "heads", "main"), sha256Hex+"\n")
+	writeTestFile(t, filepath.Join(gitDir, "refs", "heads", "feature", "loose"), sha256Hex+"\n")
+	writeTestFile(t, filepath.Join(gitDir, "packed-refs"), fmt.Sprintf(
+		"# pack-refs with: peeled fully-peeled sorted\n%s refs/tags/v1.0.0\n^%s\n%s refs/remotes/origin/packed\n",
+		sha256Hex, sha256Hex, sha256Hex))
+
+	worktreeGitDir := filepath.Join(gitDir, "worktrees", "linked")
+	writeTestFile(t, filepath.Join(worktreeGitDir, "commondir"), "../..\n")
+	writeTestFile(t, filepath.Join(worktreeGitDir, "HEAD"), sha256Hex+"\n")
+	writeTestFile(t, filepath.Join(root, "linked", ".git"), "gitdir: "+worktreeGitDir+"\n")
+
+	cases := []struct {
+		annotation string
+		dir        string
+		name       string
+		expected   bool
+	}{
+		{"head", "repo", "HEAD", true},
+		{"at", "repo", "@", true},
+		{"loose_branch", "repo", "main", true},
+		{"nested_loose_branch", "repo", "feature/loose", true},
+		{"full_ref", "repo", "refs/heads/main", true},
+		{"packed_tag", "repo", "v1.0.0", true},
+		{"packed_remote", "repo", "origin/packed", true},
+		{"absent", "repo", "v2.0.0", false},
+		{"not_ref_file", "repo", "config", false},
+		{"directory", "repo", "feature", false},
+		{"worktree_head", "linked", "HEAD", true},
+		{"worktree_common_ref", "linked", "v1.0.0", true},
+	}
+
+	for _, tc := range cases {
+		repository, err := findGitRepository(filepath.Join(root, tc.dir))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		actual, err := repository.resolveRef(tc.name)
+
+		format := "\n annotation: %s\n expected:   %v\n actual:     %v\n name:       %s"
+		if err != nil || tc.expected != actual {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, actual, tc.name))
+		}
+	}
+}
+
+func TestValidator_gitRepositoryValidate_Empty(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, ".git", "HEAD"), "ref: refs/heads/main\n")
+	chdirForTest(t, root)
+
+	sut := newValidatorSut("")
+	sut.gitRefExists = true
+	sut.gitTagAbsent = true
+	sut.gitRefExistsValidate()
+	sut.gitTagAbsentValidate()
+	assert(t, "", sut.Errors, "", "empty")
+}
+
+func TestFindGitRepository_NotFound(t *testing.T) {
+	_, err := findGitRepository(t.TempDir())
+
+	expected := "requires a git repository in the working directory"
+	if err == nil || err.Error() != expected {
+		t.Errorf("\n expected: %s\n actual:   %v", expected, err)
+	}
+}
+
+func writeTestFile(t *testing.T, name string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func chdirForTest(t *testing.T, dir string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
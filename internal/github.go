@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v *Validator) githubRepoValidate() {
+	if !v.githubRepo {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isGitHubRepo, "must be a valid GitHub repository (owner/repo)"))
+}
+
+func (v *Validator) githubUserValidate() {
+	if !v.githubUser {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isGitHubUser, "must be a valid GitHub username"))
+}
+
+func (v *Validator) githubActionRefValidate() {
+	if !v.githubActionRef && !v.githubActionPinned {
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	action, ok := parseGitHubActionRef(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid GitHub Actions reference"))
+		return
+	}
+	if v.githubActionPinned && !action.isPinned() {
+		v.AddValidationError(fmt.Errorf("must be pinned to a full-length commit SHA"))
+	}
+}
+
+// gitHubActionRef represents the "uses" syntax of GitHub Actions workflows:
+// "{owner}/{repo}[/{path}]@{ref}", "./{path}", or "docker://{image}".
+type gitHubActionRef struct {
+	local  bool
+	docker *imageReference
+	ref    string
+}
+
+func (a *gitHubActionRef) isPinned() bool {
+	if a.local {
+		return true
+	}
+	if a.docker != nil {
+		return a.docker.digest != ""
+	}
+	return len(a.ref) == 40 && lowerHexRegexp.MatchString(a.ref)
+}
+
+func parseGitHubActionRef(value string) (*gitHubActionRef, bool) {
+	if strings.HasPrefix(value, "./") {
+		return &gitHubActionRef{local: true}, !strings.ContainsFunc(value, isControlOrSpace)
+	}
+	if image, found := strings.CutPrefix(value, "docker://"); found {
+		docker, ok := parseImageReference(image)
+		return &gitHubActionRef{docker: docker}, ok
+	}
+
+	action, ref, found := strings.Cut(value, "@")
+	if !found || !isGitRefName(ref) {
+		return nil, false
+	}
+	segments := strings.Split(action, "/")
+	if len(segments) < 2 || !isGitHubRepo(segments[0]+"/"+segments[1]) {
+		return nil, false
+	}
+	for _, segment := range segments[2:] {
+		if segment == "" || segment == "." || segment == ".." {
+			return nil, false
+		}
+	}
+	return &gitHubActionRef{ref: ref}, true
+}
+
+func isGitHubRepo(value string) bool {
+	owner, repo, found := strings.Cut(value, "/")
+	if !found || !isGitHubUser(owner) {
+		return false
+	}
+	return gitHubRepoNameRegexp.MatchString(repo) && repo != "." && repo != ".."
+}
+
+// isGitHubUser follows the naming rules of GitHub usernames and organizations:
+// up to 39 alphanumeric characters or single hyphens, not starting or ending with a hyphen.
+func isGitHubUser(value string) bool {
+	return len(value) <= 39 && gitHubUserRegexp.MatchString(value)
+}
+
+var gitHubUserRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`)
+var gitHubRepoNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,100}$`)
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestValidator_githubRepoValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "tmknom/valid", ""},
+		{"valid2", "my-org/repo.name_1", ""},
+		{"valid3", "octocat/.github", ""},
+		{"invalid1", "tmknom", "must be a valid GitHub repository (owner/repo)"},
+		{"invalid2", "-tmknom/valid", "must be a valid GitHub repository (owner/repo)"},
+		{"invalid3", "tmknom/valid/extra", "must be a valid GitHub repository (owner/repo)"},
+		{"invalid4", "tmknom/..", "must be a valid GitHub repository (owner/repo)"},
+		{"invalid5", "tmknom/va lid", "must be a valid GitHub repository (owner/repo)"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.githubRepo = true
+		sut.githubRepoValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_githubUserValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "tmknom", ""},
+		{"valid2", "my-org-123", ""},
+		{"valid3", "a23456789012345678901234567890123456789", ""},
+		{"invalid1", "a234567890123456789012345678901234567890", "must be a valid GitHub username"},
+		{"invalid2", "my--org", "must be a valid GitHub username"},
+		{"invalid3", "my-org-", "must be a valid GitHub username"},
+		{"invalid4", "my_org", "must be a valid GitHub username"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.githubUser = true
+		sut.githubUserValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_githubActionRefValidate(t *testing.T) {
+	sha1 := "11bd71901bbe5b1630ceea73d27597364c9af683"
+	cases := []struct {
+		annotation string
+		value      string
+		pinned     bool
+		expected   string
+	}{
+		{"valid1", "actions/checkout@v4", false, ""},
+		{"valid2", "github/codeql-action/init@v3", false, ""},
+		{"valid3", "./.github/actions/setup", false, ""},
+		{"valid4", "docker://alpine:3.20", false, ""},
+		{"valid5", "actions/checkout@" + sha1, true, ""},
+		{"valid6", "./.github/actions/setup", true, ""},
+		{"valid7", "docker://alpine@sha256:" + sha256Hex, true, ""},
+		{"invalid1", "actions/checkout", false, "must be a valid GitHub Actions reference"},
+		{"invalid2", "checkout@v4", false, "must be a valid GitHub Actions reference"},
+		{"invalid3", "actions/checkout@", false, "must be a valid GitHub Actions reference"},
+		{"invalid4", "actions/checkout/../x@v4", false, "must be a valid GitHub Actions reference"},
+		{"invalid5", "docker://Alpine", false, "must be a valid GitHub Actions reference"},
+		{"unpinned1", "actions/checkout@v4", true, "must be pinned to a full-length commit SHA"},
+		{"unpinned2", "actions/checkout@" + sha1[:7], true, "must be pinned to a full-length commit SHA"},
+		{"unpinned3", "docker://alpine:3.20", true, "must be pinned to a full-length commit SHA"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.githubActionRef = true
+		sut.githubActionPinned = tc.pinned
+		sut.githubActionRefValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
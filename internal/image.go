@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func (v *Validator) imageRefValidate() {
+	if !v.imageRef && !v.imageRequireDigest && v.imageAllowedRegistries == "" && !v.imageForbidLatest {
+		return
+	}
+	if !v.validHostPatterns("--image-allowed-registries", v.imageAllowedRegistries) {
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	ref, ok := parseImageReference(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid container image reference"))
+		return
+	}
+	if v.imageRequireDigest && ref.digest == "" {
+		v.AddValidationError(fmt.Errorf("must be pinned by digest"))
+	}
+	if v.imageAllowedRegistries != "" {
+		registries := splitList(v.imageAllowedRegistries)
+		if !matchHostPatterns(registries, ref.registry) {
+			v.AddValidationError(fmt.Errorf("registry must match one of %v", registries))
+		}
+	}
+	if v.imageForbidLatest && (ref.tag == "latest" || (ref.tag == "" && ref.digest == "")) {
+		v.AddValidationError(fmt.Errorf("must not use the latest tag"))
+	}
+}
+
+func (v *Validator) digestValidate() {
+	if v.digest == "" {
+		return
+	}
+
+	algorithm := strings.ToLower(v.digest)
+	if _, ok := digestLengths[algorithm]; !ok {
+		v.AddArgumentError(fmt.Errorf("--digest must be one of [sha256 sha384 sha512]"))
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+	if !isDigest(v.UnmaskedValue, algorithm) {
+		v.AddValidationError(fmt.Errorf("must be a valid %s digest", algorithm))
+	}
+}
+
+type imageReference struct {
+	registry   string
+	repository string
+	tag        string
+	digest     string
+}
+
+// parseImageReference parses value according to the reference grammar of the OCI distribution spec.
+// The registry defaults to "docker.io" when value does not start with a registry host.
+func parseImageReference(value string) (*imageReference, bool) {
+	matches := imageReferenceRegexp.FindStringSubmatch(value)
+	if matches == nil || len(matches[1]) > 255 {
+		return nil, false
+	}
+
+	ref := &imageReference{registry: "docker.io", repository: matches[1], tag: matches[2], digest: matches[3]}
+	if registry, repository, found := strings.Cut(ref.repository, "/"); found && isRegistryHost(registry) {
+		ref.registry = registry
+		ref.repository = repository
+	}
+	if ref.registry == "index.docker.io" {
+		ref.registry = "docker.io"
+	}
+	if ref.digest != "" {
+		algorithm, _, _ := strings.Cut(ref.digest, ":")
+		if _, ok := digestLengths[algorithm]; ok && !isDigest(ref.digest, algorithm) {
+			return nil, false
+		}
+	}
+	return ref, true
+}
+
+// isRegistryHost reports whether the first component of an image name is a registry host,
+// following the same heuristics as the Docker CLI.
+func isRegistryHost(component string) bool {
+	return strings.ContainsAny(component, ".:") || component == "localhost" || strings.ToLower(component) != component
+}
+
+func isDigest(value string, algorithm string) bool {
+	hex, found := strings.CutPrefix(value, algorithm+":")
+	return found && len(hex) == digestLengths[algorithm] && lowerHexRegexp.MatchString(hex)
+}
+
+var digestLengths = map[string]int{
+	"sha256": 64,
+	"sha384": 96,
+	"sha512": 128,
+}
+
+const (
+	imageDomainComponent = `(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])`
+	imageDomain          = `(?:` + imageDomainComponent + `(?:\.` + imageDomainComponent + `)*|\[[a-fA-F0-9:]+\])(?::[0-9]+)?`
+	imagePathComponent   = `[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*`
+	imageName            = `(?:` + imageDomain + `/)?` + imagePathComponent + `(?:/` + imagePathComponent + `)*`
+	imageTag             = `[\w][\w.-]{0,127}`
+	imageDigest          = `[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}`
+)
+
+var imageReferenceRegexp = regexp.MustCompile(`^(` + imageName + `)(?::(` + imageTag + `))?(?:@(` + imageDigest + `))?$`)
+var lowerHexRegexp = regexp.MustCompile(`^[0-9a-f]+$`)
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidator_imageRefValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "nginx", ""},
+		{"valid2", "nginx:1.27-alpine", ""},
+		{"valid3", "ghcr.io/tmknom/valid:v1.0.0", ""},
+		{"valid4", "localhost:5000/foo/bar_baz__qux", ""},
+		{"valid5", "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/app@sha256:" + sha256Hex, ""},
+		{"valid6", "[::1]:5000/app:latest", ""},
+		{"invalid1", "Nginx", "must be a valid container image reference"},
+		{"invalid2", "nginx:", "must be a valid container image reference"},
+		{"invalid3", "nginx:-tag", "must be a valid container image reference"},
+		{"invalid4", "nginx@sha256:abc", "must be a valid container image reference"},
+		{"invalid5", "example.com/-app", "must be a valid container image reference"},
+		{"invalid6", "nginx@sha256:" + sha256Hex[:63], "must be a valid container image reference"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.imageRef = true
+		sut.imageRefValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_imageRefValidate_Policy(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		setup      func(v *Validator)
+		expected   string
+	}{
+		{"require_digest_valid", "nginx:1.27@sha256:" + sha256Hex, func(v *Validator) { v.imageRequireDigest = true }, ""},
+		{"require_digest_invalid", "nginx:1.27", func(v *Validator) { v.imageRequireDigest = true }, "must be pinned by digest"},
+		{"allowed_registries_valid1", "ghcr.io/tmknom/valid", func(v *Validator) { v.imageAllowedRegistries = "ghcr.io" }, ""},
+		{"allowed_registries_valid2", "nginx", func(v *Validator) { v.imageAllowedRegistries = "docker.io" }, ""},
+		{"allowed_registries_valid3", "123456789012.dkr.ecr.us-east-1.amazonaws.com/app", func(v *Validator) { v.imageAllowedRegistries = "*.amazonaws.com" }, ""},
+		{"allowed_registries_invalid_wildcard", "evilamazonaws.com/app", func(v *Validator) { v.imageAllowedRegistries = "*.amazonaws.com" }, "registry must match one of [*.amazonaws.com]"},
+		{"allowed_registries_invalid", "nginx", func(v *Validator) { v.imageAllowedRegistries = "ghcr.io" }, "registry must match one of [ghcr.io]"},
+		{"forbid_latest_valid1", "nginx:1.27", func(v *Validator) { v.imageForbidLatest = true }, ""},
+		{"forbid_latest_valid2", "nginx@sha256:" + sha256Hex, func(v *Validator) { v.imageForbidLatest = true }, ""},
+		{"forbid_latest_invalid1", "nginx:latest", func(v *Validator) { v.imageForbidLatest = true }, "must not use the latest tag"},
+		{"forbid_latest_invalid2", "nginx", func(v *Validator) { v.imageForbidLatest = true }, "must not use the latest tag"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		tc.setup(sut)
+		sut.imageRefValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_digestValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "sha256:" + sha256Hex, "sha256", ""},
+		{"valid2", "sha512:" + sha256Hex + sha256Hex, "SHA512", ""},
+		{"invalid1", "sha256:" + sha256Hex[:63], "sha256", "must be a valid sha256 digest"},
+		{"invalid2", "sha512:" + sha256Hex, "sha256", "must be a valid sha256 digest"},
+		{"invalid3", "sha256:" + strings.ToUpper(sha256Hex), "sha256", "must be a valid sha256 digest"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.digest = tc.argument
+		sut.digestValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+const sha256Hex = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+func TestValidator_imageRefValidate_ArgumentError(t *testing.T) {
+	sut := newValidatorSut("evilamazonaws.com/app")
+	sut.imageAllowedRegistries = "*amazonaws.com"
+	sut.imageRefValidate()
+
+	expected := "Argument error: --image-allowed-registries \"*amazonaws.com\" is not a valid host pattern, use *.example.com for subdomains."
+	if sut.Errors.Error() != expected {
+		t.Errorf(formatMessage(expected, sut.Errors, sut.UnmaskedValue, sut.imageAllowedRegistries))
+	}
+}
@@ -0,0 +1,215 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+func (v *Validator) jwtValidate() {
+	if !v.jwt && v.jwtAlgorithms == "" && v.jwtIssuers == "" && v.jwtAudiences == "" && v.jwtKey == "" {
+		return
+	}
+	// tokens are credentials, so they never appear in error messages
+	v.MaskValue()
+
+	var key []byte
+	if v.jwtKey != "" {
+		var err error
+		if key, err = os.ReadFile(v.jwtKey); err != nil {
+			v.AddArgumentError(fmt.Errorf("--jwt-key cannot read the file: %s", v.jwtKey))
+			return
+		}
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	token, ok := parseJWT(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid JWT"))
+		return
+	}
+
+	// the unsecured "none" algorithm is rejected unless explicitly allowed
+	algorithms := splitList(v.jwtAlgorithms)
+	if v.jwtAlgorithms == "" && token.header.Algorithm == "none" {
+		v.AddValidationError(fmt.Errorf("alg must not be none"))
+	} else if v.jwtAlgorithms != "" && !slices.Contains(algorithms, token.header.Algorithm) {
+		v.AddValidationError(fmt.Errorf("alg must be one of %v", algorithms))
+	}
+
+	now := time.Now()
+	if token.claims.ExpiresAt != nil && !now.Before(numericDate(*token.claims.ExpiresAt)) {
+		v.AddValidationError(fmt.Errorf("exp must not be in the past"))
+	}
+	if token.claims.NotBefore != nil && now.Before(numericDate(*token.claims.NotBefore)) {
+		v.AddValidationError(fmt.Errorf("nbf must not be in the future"))
+	}
+	if v.jwtIssuers != "" {
+		issuers := splitList(v.jwtIssuers)
+		if !slices.Contains(issuers, token.claims.Issuer) {
+			v.AddValidationError(fmt.Errorf("iss must be one of %v", issuers))
+		}
+	}
+	if v.jwtAudiences != "" {
+		audiences := splitList(v.jwtAudiences)
+		if !slices.ContainsFunc(token.claims.Audience, func(audience string) bool { return slices.Contains(audiences, audience) }) {
+			v.AddValidationError(fmt.Errorf("aud must contain one of %v", audiences))
+		}
+	}
+	if key != nil && !token.verify(key) {
+		v.AddValidationError(fmt.Errorf("signature must be valid"))
+	}
+}
+
+// jwtToken is a JWT in the JWS compact serialization defined in RFC 7515 and RFC 7519.
+type jwtToken struct {
+	header       jwtHeader
+	claims       jwtClaims
+	signingInput string
+	signature    []byte
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Issuer    string      `json:"iss"`
+	Audience  jwtAudience `json:"aud"`
+	ExpiresAt *float64    `json:"exp"`
+	NotBefore *float64    `json:"nbf"`
+}
+
+// jwtAudience accepts both a single string and an array of strings.
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(a))
+}
+
+func parseJWT(value string) (*jwtToken, bool) {
+	segments := strings.Split(value, ".")
+	if len(segments) != 3 {
+		return nil, false
+	}
+	decoded := make([][]byte, len(segments))
+	for i, segment := range segments {
+		var err error
+		if decoded[i], err = base64.RawURLEncoding.Strict().DecodeString(segment); err != nil {
+			return nil, false
+		}
+	}
+
+	token := &jwtToken{signingInput: segments[0] + "." + segments[1], signature: decoded[2]}
+	if err := json.Unmarshal(decoded[0], &token.header); err != nil || token.header.Algorithm == "" {
+		return nil, false
+	}
+	if !bytes.HasPrefix(decoded[1], []byte("{")) || json.Unmarshal(decoded[1], &token.claims) != nil {
+		return nil, false
+	}
+	if (token.header.Algorithm == "none") != (len(token.signature) == 0) {
+		return nil, false
+	}
+	return token, true
+}
+
+// numericDate converts the NumericDate of JWT, which may have fractional seconds.
+func numericDate(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+// verify verifies the signature with the key, which is a shared secret for HMAC,
+// or a PEM-encoded public key or certificate for the others.
+func (t *jwtToken) verify(key []byte) bool {
+	hashes := map[string]crypto.Hash{"256": crypto.SHA256, "384": crypto.SHA384, "512": crypto.SHA512}
+	algorithm := t.header.Algorithm
+	if algorithm == "EdDSA" {
+		publicKey, ok := readJWTPublicKey(key).(ed25519.PublicKey)
+		return ok && ed25519.Verify(publicKey, []byte(t.signingInput), t.signature)
+	}
+	if len(algorithm) != 5 {
+		return false
+	}
+	hash, ok := hashes[algorithm[2:]]
+	if !ok {
+		return false
+	}
+	digest := hash.New()
+	digest.Write([]byte(t.signingInput))
+	hashed := digest.Sum(nil)
+
+	switch algorithm[:2] {
+	case "HS":
+		// a public key must not be used as the shared secret, otherwise anyone could forge tokens
+		if bytes.Contains(key, []byte("-----BEGIN ")) {
+			return false
+		}
+		mac := hmac.New(hash.New, key)
+		mac.Write([]byte(t.signingInput))
+		return hmac.Equal(mac.Sum(nil), t.signature)
+	case "RS":
+		publicKey, ok := readJWTPublicKey(key).(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(publicKey, hash, hashed, t.signature) == nil
+	case "PS":
+		publicKey, ok := readJWTPublicKey(key).(*rsa.PublicKey)
+		return ok && rsa.VerifyPSS(publicKey, hash, hashed, t.signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+	case "ES":
+		publicKey, ok := readJWTPublicKey(key).(*ecdsa.PublicKey)
+		if !ok {
+			return false
+		}
+		// the curve must match the hash, such as P-256 for ES256, and the signature is R || S of the curve size
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		if len(t.signature) != 2*size || hash.Size()*8 != map[int]int{32: 256, 48: 384, 66: 512}[size] {
+			return false
+		}
+		r, s := new(big.Int).SetBytes(t.signature[:size]), new(big.Int).SetBytes(t.signature[size:])
+		return ecdsa.Verify(publicKey, hashed, r, s)
+	default:
+		return false
+	}
+}
+
+func readJWTPublicKey(key []byte) crypto.PublicKey {
+	blocks, ok := decodePEMBlocks(string(key))
+	if !ok {
+		return nil
+	}
+	switch blocks[0].Type {
+	case "CERTIFICATE":
+		if certificate, err := x509.ParseCertificate(blocks[0].Bytes); err == nil {
+			return certificate.PublicKey
+		}
+	case "RSA PUBLIC KEY":
+		if publicKey, err := x509.ParsePKCS1PublicKey(blocks[0].Bytes); err == nil {
+			return publicKey
+		}
+	default:
+		if publicKey, err := x509.ParsePKIXPublicKey(blocks[0].Bytes); err == nil {
+			return publicKey
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestValidator_jwtValidate(t *testing.T) {
+	now := time.Now().Unix()
+	secret := []byte("secret")
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid", newTestHS256JWT(`{"alg":"HS256","typ":"JWT"}`, `{"sub":"1234567890"}`, secret), ""},
+		{"valid_exp_nbf", newTestHS256JWT(`{"alg":"HS256"}`, fmt.Sprintf(`{"exp":%d,"nbf":%d}`, now+60, now-60), secret), ""},
+		{"valid_fractional_exp", newTestHS256JWT(`{"alg":"HS256"}`, fmt.Sprintf(`{"exp":%d.5}`, now+60), secret), ""},
+		{"expired", newTestHS256JWT(`{"alg":"HS256"}`, fmt.Sprintf(`{"exp":%d}`, now-60), secret), "exp must not be in the past"},
+		{"not_before", newTestHS256JWT(`{"alg":"HS256"}`, fmt.Sprintf(`{"nbf":%d}`, now+60), secret), "nbf must not be in the future"},
+		{"none", newTestJWT(`{"alg":"none"}`, `{}`, nil), "alg must not be none"},
+		{"two_segments", "eyJhbGciOiJIUzI1NiJ9.e30", "must be a valid JWT"},
+		{"padded_segment", newTestHS256JWT(`{"alg":"HS256"}`, `{}`, secret) + "=", "must be a valid JWT"},
+		{"missing_alg", newTestHS256JWT(`{"typ":"JWT"}`, `{}`, secret), "must be a valid JWT"},
+		{"claims_not_object", newTestHS256JWT(`{"alg":"HS256"}`, `[]`, secret), "must be a valid JWT"},
+		{"invalid_exp", newTestHS256JWT(`{"alg":"HS256"}`, `{"exp":"tomorrow"}`, secret), "must be a valid JWT"},
+		{"missing_signature", newTestJWT(`{"alg":"HS256"}`, `{}`, nil), "must be a valid JWT"},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.jwt = true
+		sut.jwtValidate()
+		// tokens are always masked
+		assert(t, tc.expected, sut.Errors, MaskedValue, tc.annotation)
+	}
+}
+
+func TestValidator_jwtValidate_Claims(t *testing.T) {
+	secret := []byte("secret")
+	cases := []struct {
+		annotation string
+		value      string
+		algorithms string
+		issuers    string
+		audiences  string
+		expected   string
+	}{
+		{"algorithm_valid", newTestHS256JWT(`{"alg":"HS256"}`, `{}`, secret), "HS256,RS256", "", "", ""},
+		{"algorithm_invalid", newTestHS256JWT(`{"alg":"HS256"}`, `{}`, secret), "RS256, ES256", "", "", "alg must be one of [RS256 ES256]"},
+		{"algorithm_none_allowed", newTestJWT(`{"alg":"none"}`, `{}`, nil), "none", "", "", ""},
+		{"issuer_valid", newTestHS256JWT(`{"alg":"HS256"}`, `{"iss":"https://issuer.example.com"}`, secret), "", "https://issuer.example.com", "", ""},
+		{"issuer_invalid", newTestHS256JWT(`{"alg":"HS256"}`, `{"iss":"https://evil.example.com"}`, secret), "", "https://issuer.example.com", "", "iss must be one of [https://issuer.example.com]"},
+		{"issuer_missing", newTestHS256JWT(`{"alg":"HS256"}`, `{}`, secret), "", "https://issuer.example.com", "", "iss must be one of [https://issuer.example.com]"},
+		{"audience_string", newTestHS256JWT(`{"alg":"HS256"}`, `{"aud":"api"}`, secret), "", "", "api,web", ""},
+		{"audience_array", newTestHS256JWT(`{"alg":"HS256"}`, `{"aud":["cli","web"]}`, secret), "", "", "api,web", ""},
+		{"audience_invalid", newTestHS256JWT(`{"alg":"HS256"}`, `{"aud":["cli"]}`, secret), "", "", "api,web", "aud must contain one of [api web]"},
+		{"multiple_issues", newTestHS256JWT(`{"alg":"HS256"}`, `{"iss":"other","aud":null}`, secret), "RS256", "issuer", "api", "alg must be one of [RS256], iss must be one of [issuer], aud must contain one of [api]"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.jwtAlgorithms = tc.algorithms
+		sut.jwtIssuers = tc.issuers
+		sut.jwtAudiences = tc.audiences
+		sut.jwtValidate()
+		assert(t, tc.expected, sut.Errors, MaskedValue, tc.annotation)
+	}
+}
+
+func TestValidator_jwtValidate_Signature(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "secret")
+	writeTestFile(t, secretFile, "secret")
+
+	ecKey := newTestECDSAKey(t)
+	ecKeyFile := filepath.Join(dir, "ec.pem")
+	writeTestFile(t, ecKeyFile, pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, ecKey.Public())))
+	certificateFile := filepath.Join(dir, "certificate.pem")
+	writeTestFile(t, certificateFile, newTestCertificate(t, ecKey, time.Now(), time.Now().Add(time.Hour)))
+
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKeyFile := filepath.Join(dir, "ed25519.pem")
+	writeTestFile(t, edKeyFile, pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, edPublicKey)))
+
+	es256 := newTestES256JWT(t, `{"alg":"ES256"}`, `{"sub":"user"}`, ecKey)
+	eddsa := newTestJWT(`{"alg":"EdDSA"}`, `{"sub":"user"}`, func(input []byte) []byte { return ed25519.Sign(edKey, input) })
+	cases := []struct {
+		annotation string
+		value      string
+		key        string
+		expected   string
+	}{
+		{"hs256_valid", newTestHS256JWT(`{"alg":"HS256"}`, `{}`, []byte("secret")), secretFile, ""},
+		{"hs256_invalid", newTestHS256JWT(`{"alg":"HS256"}`, `{}`, []byte("other")), secretFile, "signature must be valid"},
+		{"es256_valid", es256, ecKeyFile, ""},
+		{"es256_certificate", es256, certificateFile, ""},
+		{"es256_tampered", es256[:len(es256)-4] + "AAAA", ecKeyFile, "signature must be valid"},
+		{"es256_wrong_key", es256, edKeyFile, "signature must be valid"},
+		{"eddsa_valid", eddsa, edKeyFile, ""},
+		// the algorithm confusion: the public key must not be used as the HMAC secret
+		{"hs256_with_public_key", newTestHS256JWT(`{"alg":"HS256"}`, `{}`, []byte(pemEncodeForTest("PUBLIC KEY", marshalPKIXForTest(t, ecKey.Public())))), ecKeyFile, "signature must be valid"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.jwtKey = tc.key
+		sut.jwtValidate()
+		assert(t, tc.expected, sut.Errors, MaskedValue, tc.annotation)
+	}
+}
+
+func TestValidator_jwtValidate_ArgumentError(t *testing.T) {
+	sut := newValidatorSut("token")
+	sut.jwtKey = "not-found"
+	sut.jwtValidate()
+
+	expected := "Argument error: --jwt-key cannot read the file: not-found."
+	if sut.Errors.Error() != expected {
+		t.Errorf(fmt.Sprintf("\n expected: %s\n actual:   %s", expected, sut.Errors.Error()))
+	}
+}
+
+func newTestJWT(header string, claims string, sign func(input []byte) []byte) string {
+	input := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+	if sign == nil {
+		return input + "."
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(sign([]byte(input)))
+}
+
+func newTestHS256JWT(header string, claims string, secret []byte) string {
+	return newTestJWT(header, claims, func(input []byte) []byte {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(input)
+		return mac.Sum(nil)
+	})
+}
+
+func newTestES256JWT(t *testing.T, header string, claims string, key *ecdsa.PrivateKey) string {
+	return newTestJWT(header, claims, func(input []byte) []byte {
+		digest := sha256.Sum256(input)
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	})
+}
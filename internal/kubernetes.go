@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// The following rules and messages follow the validation of k8s.io/apimachinery/pkg/util/validation.
+
+func (v *Validator) k8sNameValidate() {
+	if v.k8sName == "" {
+		return
+	}
+
+	validators := map[string]func(string) []string{
+		"dns1123-label":     isDNS1123Label,
+		"dns1123-subdomain": isDNS1123Subdomain,
+		"path-segment":      isPathSegmentName,
+	}
+	validator, ok := validators[strings.ToLower(v.k8sName)]
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--k8s-name must be one of [dns1123-label dns1123-subdomain path-segment]"))
+		return
+	}
+	v.addK8sErrors(validator)
+}
+
+func (v *Validator) k8sLabelKeyValidate() {
+	if !v.k8sLabelKey {
+		return
+	}
+	v.addK8sErrors(isQualifiedName)
+}
+
+func (v *Validator) k8sLabelValueValidate() {
+	if !v.k8sLabelValue {
+		return
+	}
+	v.addK8sErrors(isLabelValue)
+}
+
+func (v *Validator) k8sAnnotationKeyValidate() {
+	if !v.k8sAnnotationKey {
+		return
+	}
+	v.addK8sErrors(func(value string) []string { return isQualifiedName(strings.ToLower(value)) })
+}
+
+func (v *Validator) k8sQuantityValidate() {
+	if !v.k8sQuantity {
+		return
+	}
+	v.addK8sErrors(isQuantity)
+}
+
+func (v *Validator) addK8sErrors(validator func(string) []string) {
+	if v.UnmaskedValue == "" {
+		return
+	}
+	for _, message := range validator(v.UnmaskedValue) {
+		v.AddValidationError(fmt.Errorf("%s", message))
+	}
+}
+
+func isDNS1123Label(value string) []string {
+	var errs []string
+	if len(value) > 63 {
+		errs = append(errs, "must be no more than 63 characters")
+	}
+	if !dns1123LabelRegexp.MatchString(value) {
+		errs = append(errs, "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character")
+	}
+	return errs
+}
+
+func isDNS1123Subdomain(value string) []string {
+	var errs []string
+	if len(value) > 253 {
+		errs = append(errs, "must be no more than 253 characters")
+	}
+	if !dns1123SubdomainRegexp.MatchString(value) {
+		errs = append(errs, "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")
+	}
+	return errs
+}
+
+func isPathSegmentName(value string) []string {
+	var errs []string
+	if value == "." || value == ".." {
+		errs = append(errs, fmt.Sprintf("may not be '%s'", value))
+	}
+	for _, illegal := range []string{"/", "%"} {
+		if strings.Contains(value, illegal) {
+			errs = append(errs, fmt.Sprintf("may not contain '%s'", illegal))
+		}
+	}
+	return errs
+}
+
+// isQualifiedName validates a name with an optional DNS subdomain prefix, such as "example.com/name".
+func isQualifiedName(value string) []string {
+	parts := strings.Split(value, "/")
+	var name string
+	var errs []string
+	switch len(parts) {
+	case 1:
+		name = parts[0]
+	case 2:
+		var prefix string
+		prefix, name = parts[0], parts[1]
+		if len(prefix) == 0 {
+			errs = append(errs, "prefix part must be non-empty")
+		} else {
+			for _, message := range isDNS1123Subdomain(prefix) {
+				errs = append(errs, "prefix part "+message)
+			}
+		}
+	default:
+		return append(errs, "a qualified name must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character with an optional DNS subdomain prefix and '/'")
+	}
+
+	if len(name) == 0 {
+		errs = append(errs, "name part must be non-empty")
+	} else if len(name) > 63 {
+		errs = append(errs, "name part must be no more than 63 characters")
+	}
+	if !qualifiedNameRegexp.MatchString(name) {
+		errs = append(errs, "name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character")
+	}
+	return errs
+}
+
+func isLabelValue(value string) []string {
+	var errs []string
+	if len(value) > 63 {
+		errs = append(errs, "must be no more than 63 characters")
+	}
+	if !labelValueRegexp.MatchString(value) {
+		errs = append(errs, "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character")
+	}
+	return errs
+}
+
+// isQuantity follows the format of resource.Quantity: a signed decimal number
+// followed by an optional binary SI (Ki, Mi, ...), decimal SI (m, k, M, ...) or exponent (e3) suffix.
+func isQuantity(value string) []string {
+	matches := quantityRegexp.FindStringSubmatch(value)
+	if matches == nil || strings.Count(matches[1], ".") > 1 || strings.Trim(matches[1], "+-.") == "" {
+		return []string{"quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"}
+	}
+	return nil
+}
+
+var dns1123LabelRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+var dns1123SubdomainRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+var qualifiedNameRegexp = regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+var labelValueRegexp = regexp.MustCompile(`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`)
+var quantityRegexp = regexp.MustCompile(`^([+-]?[0-9.]+)(|[KMGTPE]i|[numkMGTPE]|[eE][-+]?[0-9]+)$`)
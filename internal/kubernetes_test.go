@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidator_k8sNameValidate(t *testing.T) {
+	label := "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character"
+	subdomain := "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"label_valid", "my-name-123", "dns1123-label", ""},
+		{"label_invalid1", "My-Name", "dns1123-label", label},
+		{"label_invalid2", "my.name", "dns1123-label", label},
+		{"label_invalid3", strings.Repeat("a", 64), "dns1123-label", "must be no more than 63 characters"},
+		{"label_invalid4", strings.Repeat("a", 63) + "-", "dns1123-label", "must be no more than 63 characters, " + label},
+		{"subdomain_valid", "my.name-123.example", "dns1123-subdomain", ""},
+		{"subdomain_invalid1", "my..name", "dns1123-subdomain", subdomain},
+		{"subdomain_invalid2", strings.Repeat("a", 254), "dns1123-subdomain", "must be no more than 253 characters"},
+		{"path_segment_valid", "My Name", "path-segment", ""},
+		{"path_segment_invalid1", "..", "path-segment", "may not be '..'"},
+		{"path_segment_invalid2", "a/b%c", "path-segment", "may not contain '/', may not contain '%'"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.k8sName = tc.argument
+		sut.k8sNameValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_k8sLabelKeyValidate(t *testing.T) {
+	name := "name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character"
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "app", ""},
+		{"valid2", "app.kubernetes.io/name", ""},
+		{"valid3", "My_Key.1", ""},
+		{"invalid1", "app.kubernetes.io/", "name part must be non-empty, " + name},
+		{"invalid2", "/name", "prefix part must be non-empty"},
+		{"invalid3", "App.io/name", "prefix part a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"},
+		{"invalid4", "a/b/c", "a qualified name must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character with an optional DNS subdomain prefix and '/'"},
+		{"invalid5", "-name", name},
+		{"invalid6", strings.Repeat("a", 64), "name part must be no more than 63 characters"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.k8sLabelKey = true
+		sut.k8sLabelKeyValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_k8sLabelValueValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "v1.2.3", ""},
+		{"valid2", "My_Value-1", ""},
+		{"invalid1", "value-", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character"},
+		{"invalid2", strings.Repeat("a", 64), "must be no more than 63 characters"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.k8sLabelValue = true
+		sut.k8sLabelValueValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_k8sAnnotationKeyValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "kubernetes.io/change-cause", ""},
+		{"valid2", "Example.com/Key", ""},
+		{"invalid", "example.com/key/extra", "a qualified name must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character with an optional DNS subdomain prefix and '/'"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.k8sAnnotationKey = true
+		sut.k8sAnnotationKeyValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_k8sQuantityValidate(t *testing.T) {
+	message := "quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "500m", ""},
+		{"valid2", "1.5Gi", ""},
+		{"valid3", "128974848", ""},
+		{"valid4", "129e6", ""},
+		{"valid5", "-1k", ""},
+		{"valid6", ".5", ""},
+		{"invalid1", "1.5GB", message},
+		{"invalid2", "1.2.3", message},
+		{"invalid3", "Gi", message},
+		{"invalid4", "1mi", message},
+		{"invalid5", ".", message},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.k8sQuantity = true
+		sut.k8sQuantityValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
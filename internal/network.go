@@ -0,0 +1,194 @@
+package internal
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v *Validator) ipValidate() {
+	if !v.ip {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isIP, "must be a valid IP address"))
+}
+
+func (v *Validator) ipv4Validate() {
+	if !v.ipv4 {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isIPv4, "must be a valid IPv4 address"))
+}
+
+func (v *Validator) ipv6Validate() {
+	if !v.ipv6 {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isIPv6, "must be a valid IPv6 address"))
+}
+
+func (v *Validator) cidrValidate() {
+	if !v.cidr {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isCIDR, "must be a valid CIDR notation"))
+}
+
+func (v *Validator) portValidate() {
+	if !v.port {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isPort, "must be a valid port number"))
+}
+
+func (v *Validator) macValidate() {
+	if !v.mac {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isMAC, "must be a valid MAC address"))
+}
+
+func (v *Validator) hostPortValidate() {
+	if !v.hostPort {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isHostPort, "must be a valid host:port"))
+}
+
+func (v *Validator) ipInValidate() {
+	if v.ipIn == "" {
+		return
+	}
+
+	var prefixes []netip.Prefix
+	for _, cidr := range strings.Split(v.ipIn, ",") {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			v.AddArgumentError(fmt.Errorf("--ip-in \"%s\" is not a valid CIDR notation", cidr))
+			return
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	addr, err := netip.ParseAddr(v.UnmaskedValue)
+	if err != nil {
+		v.AddValidationError(fmt.Errorf("must be a valid IP address"))
+		return
+	}
+	addr = addr.Unmap().WithZone("")
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return
+		}
+	}
+	v.AddValidationError(fmt.Errorf("must be within %v", prefixes))
+}
+
+func (v *Validator) ipExcludeValidate() {
+	if v.ipExclude == "" {
+		return
+	}
+
+	ranges := map[string]func(netip.Addr) bool{
+		"private":     netip.Addr.IsPrivate,
+		"loopback":    netip.Addr.IsLoopback,
+		"link-local":  func(addr netip.Addr) bool { return addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() },
+		"multicast":   netip.Addr.IsMulticast,
+		"unspecified": netip.Addr.IsUnspecified,
+	}
+
+	var excludes []string
+	for _, exclude := range strings.Split(v.ipExclude, ",") {
+		exclude = strings.ToLower(strings.TrimSpace(exclude))
+		if _, ok := ranges[exclude]; !ok {
+			keys := make([]string, 0, len(ranges))
+			for key := range ranges {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			v.AddArgumentError(fmt.Errorf("--ip-exclude must be one of %v", keys))
+			return
+		}
+		excludes = append(excludes, exclude)
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	addr, err := netip.ParseAddr(v.UnmaskedValue)
+	if err != nil {
+		v.AddValidationError(fmt.Errorf("must be a valid IP address"))
+		return
+	}
+	addr = addr.Unmap()
+	for _, exclude := range excludes {
+		if ranges[exclude](addr) {
+			v.AddValidationError(fmt.Errorf("must not be a %s address", exclude))
+		}
+	}
+}
+
+func isIP(value string) bool {
+	_, err := netip.ParseAddr(value)
+	return err == nil
+}
+
+func isIPv4(value string) bool {
+	addr, err := netip.ParseAddr(value)
+	return err == nil && addr.Is4()
+}
+
+func isIPv6(value string) bool {
+	addr, err := netip.ParseAddr(value)
+	return err == nil && addr.Is6()
+}
+
+func isCIDR(value string) bool {
+	_, err := netip.ParsePrefix(value)
+	return err == nil
+}
+
+func isMAC(value string) bool {
+	_, err := net.ParseMAC(value)
+	return err == nil
+}
+
+func isHostPort(value string) bool {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil || !isPort(port) {
+		return false
+	}
+	return isIP(host) || isHostname(host)
+}
+
+func isPort(value string) bool {
+	if value == "" || strings.TrimLeft(value, "0123456789") != "" {
+		return false
+	}
+	number, err := strconv.Atoi(value)
+	return err == nil && 0 < number && number <= 65535
+}
+
+func isHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+	if len(value) == 0 || len(value) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(value, ".") {
+		if !hostnameLabelRegexp.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+var hostnameLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestValidator_ipValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "192.168.0.1", ""},
+		{"valid2", "2001:db8::1", ""},
+		{"valid3", "fe80::1%eth0", ""},
+		{"invalid1", "192.168.0.256", "must be a valid IP address"},
+		{"invalid2", "192.168.0.1/24", "must be a valid IP address"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.ip = true
+		sut.ipValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_ipv4Validate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid", "10.0.0.1", ""},
+		{"invalid1", "2001:db8::1", "must be a valid IPv4 address"},
+		{"invalid2", "010.0.0.1", "must be a valid IPv4 address"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.ipv4 = true
+		sut.ipv4Validate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_ipv6Validate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "2001:db8::1", ""},
+		{"valid2", "::1", ""},
+		{"invalid", "10.0.0.1", "must be a valid IPv6 address"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.ipv6 = true
+		sut.ipv6Validate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_cidrValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "10.0.0.0/8", ""},
+		{"valid2", "2001:db8::/32", ""},
+		{"invalid1", "10.0.0.0/33", "must be a valid CIDR notation"},
+		{"invalid2", "10.0.0.0", "must be a valid CIDR notation"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.cidr = true
+		sut.cidrValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_portValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "1", ""},
+		{"valid2", "65535", ""},
+		{"invalid1", "0", "must be a valid port number"},
+		{"invalid2", "65536", "must be a valid port number"},
+		{"invalid3", "+80", "must be a valid port number"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.port = true
+		sut.portValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_macValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "00:00:5e:00:53:01", ""},
+		{"valid2", "00-00-5E-00-53-01", ""},
+		{"invalid", "00:00:5e:00:53", "must be a valid MAC address"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.mac = true
+		sut.macValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_hostPortValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid1", "example.com:443", ""},
+		{"valid2", "localhost:8080", ""},
+		{"valid3", "10.0.0.1:22", ""},
+		{"valid4", "[2001:db8::1]:443", ""},
+		{"invalid1", "example.com", "must be a valid host:port"},
+		{"invalid2", "example.com:0", "must be a valid host:port"},
+		{"invalid3", "-example.com:443", "must be a valid host:port"},
+		{"invalid4", "2001:db8::1:443", "must be a valid host:port"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.hostPort = true
+		sut.hostPortValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, NoArgument)
+	}
+}
+
+func TestValidator_ipInValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "10.1.2.3", "10.0.0.0/8", ""},
+		{"valid2", "192.168.1.1", "10.0.0.0/8,192.168.0.0/16", ""},
+		{"valid3", "2001:db8::1", "2001:db8::/32", ""},
+		{"empty", "", "10.0.0.0/8", ""},
+		{"invalid1", "172.16.0.1", "10.0.0.0/8,192.168.0.0/16", "must be within [10.0.0.0/8 192.168.0.0/16]"},
+		{"invalid2", "example.com", "10.0.0.0/8", "must be a valid IP address"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.ipIn = tc.argument
+		sut.ipInValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_ipExcludeValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "8.8.8.8", "private,loopback,link-local", ""},
+		{"valid2", "2001:4860:4860::8888", "private,loopback,link-local", ""},
+		{"empty", "", "private", ""},
+		{"invalid1", "10.0.0.1", "private,loopback", "must not be a private address"},
+		{"invalid2", "127.0.0.1", "private,loopback", "must not be a loopback address"},
+		{"invalid3", "fe80::1", "link-local", "must not be a link-local address"},
+		{"invalid4", "::ffff:192.168.0.1", "private", "must not be a private address"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.ipExclude = tc.argument
+		sut.ipExcludeValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
@@ -1,21 +1,45 @@
 package internal
 
-func newOrchestrator() *Orchestrator {
+import "fmt"
+
+func newOrchestrator(io *IO) *Orchestrator {
 	return &Orchestrator{
-		Value:     &Value{},
-		Validator: &Validator{Errors: &Errors{}},
-		Formatter: &Formatter{},
+		Value:       &Value{},
+		Transformer: &Transformer{},
+		Validator:   &Validator{Errors: &Errors{}},
+		Formatter:   &Formatter{},
+		IO:          io,
 	}
 }
 
 type Orchestrator struct {
 	*Value
+	*Transformer
 	*Validator
 	*Formatter
+	*IO
+	printValue bool
 }
 
 func (o *Orchestrator) Orchestrate() error {
+	if o.Transformer != nil {
+		transformed, err := o.Transformer.Transform(o.Value.Unmasked())
+		if err != nil {
+			o.Validator.Errors.AddArgumentError(err)
+		}
+		o.Value.raw = transformed
+	}
+
 	o.Validator.UnmaskedValue = o.Value.Unmasked()
 	o.Validator.Errors.value = o.Value
-	return o.Formatter.Format(o.Validator.Validate())
+	if err := o.Formatter.Format(o.Validator.Validate()); err != nil {
+		return err
+	}
+
+	// the normalized value is printed only on success, so that valid can be used as a filter in pipelines
+	if o.printValue {
+		_, err := fmt.Fprintln(o.IO.OutWriter, o.Value.Unmasked())
+		return err
+	}
+	return nil
 }
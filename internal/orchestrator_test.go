@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 )
@@ -111,3 +112,39 @@ func TestOrchestrator_Orchestrate_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestOrchestrator_Orchestrate_Transform(t *testing.T) {
+	cases := []struct {
+		annotation  string
+		value       string
+		transformer *Transformer
+		printValue  bool
+		expected    string
+		output      string
+	}{
+		{"print", " valid\n", &Transformer{trim: true}, true, "", "valid\n"},
+		{"not print", " valid\n", &Transformer{trim: true}, false, "", ""},
+		{"lower", "Valid", &Transformer{lower: true}, true, "", "valid\n"},
+		{"invalid", " Invalid\n", &Transformer{trim: true}, true, "Error: Validation error: The specified value \"Invalid\" is invalid. Issues: must be in lower case.", ""},
+		{"argument error", "valid", &Transformer{toNormalization: "nfx"}, true, "Error: Argument error: --to-normalization must be one of [nfc nfd nfkc nfkd].", ""},
+	}
+
+	for _, tc := range cases {
+		io := FakeTestIO()
+		sut := &Orchestrator{
+			Value:       &Value{raw: tc.value},
+			Transformer: tc.transformer,
+			Validator:   &Validator{Errors: &Errors{}, lowerCase: true},
+			Formatter:   &Formatter{},
+			IO:          io,
+			printValue:  tc.printValue,
+		}
+		err := sut.Orchestrate()
+
+		format := "\n annotation: %s\n expected:   %s, %q\n actual:     %+v, %q"
+		output := io.OutWriter.(*bytes.Buffer).String()
+		if tc.expected == "" && err != nil || tc.expected != "" && (err == nil || err.Error() != tc.expected) || output != tc.output {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, tc.output, err, output))
+		}
+	}
+}
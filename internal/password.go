@@ -0,0 +1,232 @@
+package internal
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func (v *Validator) passwordPolicyValidate() {
+	if !v.passwordPolicy && v.passwordMinLength == "" && v.passwordRequire == "" && v.passwordMaxRepeat == "" &&
+		v.passwordMaxSequence == "" && v.passwordDictionary == "" && v.passwordMinEntropy == "" {
+		return
+	}
+	// passwords never appear in error messages, even if --mask-value isn't specified
+	v.MaskValue()
+
+	policy, ok := v.newPasswordPolicy()
+	if !ok || v.UnmaskedValue == "" {
+		return
+	}
+
+	password := v.UnmaskedValue
+	if utf8.RuneCountInString(password) < policy.minLength {
+		v.AddValidationError(fmt.Errorf("the length must be no less than %d", policy.minLength))
+	}
+	for _, class := range policy.require {
+		if !strings.ContainsFunc(password, passwordClasses[class]) {
+			v.AddValidationError(fmt.Errorf("must contain at least one %s", passwordClassNames[class]))
+		}
+	}
+	if policy.maxRepeat > 0 && longestRun(password, isRepeated) > policy.maxRepeat {
+		v.AddValidationError(fmt.Errorf("must not repeat the same character more than %d times in a row", policy.maxRepeat))
+	}
+	if policy.maxSequence > 0 && longestRun(password, isSequential) > policy.maxSequence {
+		v.AddValidationError(fmt.Errorf("must not contain sequential characters longer than %d", policy.maxSequence))
+	}
+	// the matched word isn't reported, since it reveals a part of the password
+	if policy.dictionary != nil && len(findDictionaryWords(password, policy.dictionary)) > 0 {
+		v.AddValidationError(fmt.Errorf("must not contain dictionary words"))
+	}
+	if policy.minEntropy > 0 && estimateEntropy(password, policy.dictionary) < float64(policy.minEntropy) {
+		v.AddValidationError(fmt.Errorf("estimated entropy must be no less than %d bits", policy.minEntropy))
+	}
+}
+
+// passwordPolicy holds the requirements. --password-policy enables all requirements with the defaults,
+// and each option overrides its default. Without --password-policy, only the specified options apply.
+type passwordPolicy struct {
+	minLength   int
+	require     []string
+	maxRepeat   int
+	maxSequence int
+	dictionary  []string
+	minEntropy  int
+}
+
+func (v *Validator) newPasswordPolicy() (*passwordPolicy, bool) {
+	policy := &passwordPolicy{}
+	if v.passwordPolicy {
+		policy = &passwordPolicy{
+			minLength:   12,
+			require:     []string{"lower", "upper", "digit"},
+			maxRepeat:   3,
+			maxSequence: 4,
+			dictionary:  commonPasswords,
+			minEntropy:  50,
+		}
+	}
+
+	numbers := []struct {
+		flag  string
+		value string
+		field *int
+	}{
+		{"--password-min-length", v.passwordMinLength, &policy.minLength},
+		{"--password-max-repeat", v.passwordMaxRepeat, &policy.maxRepeat},
+		{"--password-max-sequence", v.passwordMaxSequence, &policy.maxSequence},
+		{"--password-min-entropy", v.passwordMinEntropy, &policy.minEntropy},
+	}
+	for _, number := range numbers {
+		if number.value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(number.value)
+		if err != nil || parsed < 0 {
+			v.AddArgumentError(fmt.Errorf("%s must be a non-negative integer number", number.flag))
+			return nil, false
+		}
+		*number.field = parsed
+	}
+
+	if v.passwordRequire != "" {
+		policy.require = splitList(strings.ToLower(v.passwordRequire))
+		for _, class := range policy.require {
+			if _, ok := passwordClasses[class]; !ok {
+				v.AddArgumentError(fmt.Errorf("--password-require must be a list of [lower upper digit symbol]"))
+				return nil, false
+			}
+		}
+	}
+
+	if v.passwordDictionary != "" {
+		content, err := os.ReadFile(v.passwordDictionary)
+		if err != nil {
+			v.AddArgumentError(fmt.Errorf("--password-dictionary cannot read the file: %s", v.passwordDictionary))
+			return nil, false
+		}
+		policy.dictionary = slices.Concat(commonPasswords, newDictionary(string(content)))
+	}
+	return policy, true
+}
+
+// longestRun returns the length of the longest run of characters where each adjacent pair satisfies the condition.
+func longestRun(value string, condition func(previous rune, current rune) bool) int {
+	longest, current := 0, 0
+	var previous rune
+	for i, r := range []rune(value) {
+		if i > 0 && condition(previous, r) {
+			current++
+		} else {
+			current = 1
+		}
+		longest = max(longest, current)
+		previous = r
+	}
+	return longest
+}
+
+func isRepeated(previous rune, current rune) bool {
+	return previous == current
+}
+
+// isSequential reports whether the letters or digits are consecutive in either direction, such as "ab", "21".
+func isSequential(previous rune, current rune) bool {
+	sameClass := (unicode.IsLetter(previous) && unicode.IsLetter(current)) || (unicode.IsDigit(previous) && unicode.IsDigit(current))
+	difference := unicode.ToLower(current) - unicode.ToLower(previous)
+	return sameClass && (difference == 1 || difference == -1)
+}
+
+// findDictionaryWords returns the ranges [start, end) of the runes matching the words, case-insensitively.
+func findDictionaryWords(value string, dictionary []string) [][2]int {
+	runes := []rune(strings.ToLower(value))
+	var ranges [][2]int
+	for start := 0; start < len(runes); start++ {
+		end := 0
+		for _, word := range dictionary {
+			length := utf8.RuneCountInString(word)
+			if length > end-start && start+length <= len(runes) && string(runes[start:start+length]) == word {
+				end = start + length
+			}
+		}
+		if end > 0 {
+			ranges = append(ranges, [2]int{start, end})
+			start = end - 1
+		}
+	}
+	return ranges
+}
+
+// estimateEntropy estimates the entropy in bits conservatively, like a guessing attack does.
+// A dictionary word counts as one guess among the dictionary, a repeated or sequential character
+// counts as one bit, and any other character counts as the size of the character pool in use.
+func estimateEntropy(value string, dictionary []string) float64 {
+	runes := []rune(value)
+	pool := 0
+	for class, contains := range passwordClasses {
+		if strings.ContainsFunc(value, contains) {
+			pool += passwordClassSizes[class]
+		}
+	}
+	if strings.ContainsFunc(value, func(r rune) bool { return r > unicode.MaxASCII }) {
+		pool += 100
+	}
+	if pool == 0 {
+		return 0
+	}
+
+	words := findDictionaryWords(value, dictionary)
+	entropy := 0.0
+	for i := 0; i < len(runes); i++ {
+		if len(words) > 0 && words[0][0] == i {
+			entropy += math.Log2(float64(len(dictionary))) + 1
+			i = words[0][1] - 1
+			words = words[1:]
+		} else if i > 0 && (isRepeated(runes[i-1], runes[i]) || isSequential(runes[i-1], runes[i])) {
+			entropy++
+		} else {
+			entropy += math.Log2(float64(pool))
+		}
+	}
+	return entropy
+}
+
+func newDictionary(data string) []string {
+	var words []string
+	for _, word := range strings.Fields(strings.ToLower(data)) {
+		// short words are ignored to avoid matching almost every password
+		if utf8.RuneCountInString(word) >= 4 {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+var passwordClasses = map[string]func(rune) bool{
+	"lower":  unicode.IsLower,
+	"upper":  unicode.IsUpper,
+	"digit":  unicode.IsDigit,
+	"symbol": func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) || r == ' ' },
+}
+
+var passwordClassNames = map[string]string{
+	"lower":  "lowercase letter",
+	"upper":  "uppercase letter",
+	"digit":  "digit",
+	"symbol": "symbol",
+}
+
+var passwordClassSizes = map[string]int{"lower": 26, "upper": 26, "digit": 10, "symbol": 33}
+
+// commonPasswords is a short list of the most common passwords and words used in passwords.
+//
+//go:embed data/common-passwords.txt
+var commonPasswordsData string
+
+var commonPasswords = newDictionary(commonPasswordsData)
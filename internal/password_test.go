@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidator_passwordPolicyValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"valid", "xK9#mQ2$vL7@pR4!", ""},
+		{"valid_passphrase", "Correct horse 7 battery staple", ""},
+		{"too_short", "xK9#mQ2$vL", "the length must be no less than 12"},
+		{"missing_classes", "xk#mq$vl@pr!zt&w", "must contain at least one uppercase letter, must contain at least one digit"},
+		{"repeated", "xK9#mQ2$vLLLL7@pR4!", "must not repeat the same character more than 3 times in a row"},
+		{"sequential", "xK9#mQ2$vL56789@pR!", "must not contain sequential characters longer than 4"},
+		{"dictionary", "xK9#mQ2$Dragon@pR!", "must not contain dictionary words"},
+		{"low_entropy", "Password123!", "must not contain dictionary words, estimated entropy must be no less than 50 bits"},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.passwordPolicy = true
+		sut.passwordPolicyValidate()
+		// passwords are always masked
+		assert(t, tc.expected, sut.Errors, MaskedValue, tc.annotation)
+	}
+}
+
+func TestValidator_passwordPolicyValidate_Options(t *testing.T) {
+	dictionary := filepath.Join(t.TempDir(), "words.txt")
+	writeTestFile(t, dictionary, "acme\nrocket\nab\n")
+	cases := []struct {
+		annotation  string
+		value       string
+		policy      bool
+		minLength   string
+		require     string
+		maxRepeat   string
+		maxSequence string
+		dictionary  string
+		minEntropy  string
+		expected    string
+	}{
+		{"min_length_only", "short", false, "6", "", "", "", "", "", "the length must be no less than 6"},
+		{"min_length_runes", "パスワード", false, "5", "", "", "", "", "", ""},
+		{"require_only", "password", false, "", "upper,symbol", "", "", "", "", "must contain at least one uppercase letter, must contain at least one symbol"},
+		{"max_repeat_only", "aaab", false, "", "", "2", "", "", "", "must not repeat the same character more than 2 times in a row"},
+		{"max_sequence_descending", "zyx", false, "", "", "", "2", "", "", "must not contain sequential characters longer than 2"},
+		{"max_sequence_case_insensitive", "aBc", false, "", "", "", "2", "", "", "must not contain sequential characters longer than 2"},
+		{"dictionary_file", "my-Rocket-ship", false, "", "", "", "", dictionary, "", "must not contain dictionary words"},
+		{"dictionary_file_short_words_ignored", "xabx", false, "", "", "", "", dictionary, "", ""},
+		{"dictionary_file_includes_common", "qwerty", false, "", "", "", "", dictionary, "", "must not contain dictionary words"},
+		{"min_entropy_only", "aaaaaaaa", false, "", "", "", "", "", "30", "estimated entropy must be no less than 30 bits"},
+		{"override_default", "xK9#mQ2$", true, "8", "", "", "", "", "40", ""},
+		{"disable_default", "xk9#mq2$vl7@pr4!", true, "", "lower", "", "", "", "0", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.passwordPolicy = tc.policy
+		sut.passwordMinLength = tc.minLength
+		sut.passwordRequire = tc.require
+		sut.passwordMaxRepeat = tc.maxRepeat
+		sut.passwordMaxSequence = tc.maxSequence
+		sut.passwordDictionary = tc.dictionary
+		sut.passwordMinEntropy = tc.minEntropy
+		sut.passwordPolicyValidate()
+		assert(t, tc.expected, sut.Errors, MaskedValue, tc.annotation)
+	}
+}
+
+func TestValidator_passwordPolicyValidate_ArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation string
+		minLength  string
+		require    string
+		dictionary string
+		expected   string
+	}{
+		{"invalid_min_length", "twelve", "", "", "Argument error: --password-min-length must be a non-negative integer number."},
+		{"invalid_require", "", "lower,emoji", "", "Argument error: --password-require must be a list of [lower upper digit symbol]."},
+		{"missing_dictionary", "", "", "not-found", "Argument error: --password-dictionary cannot read the file: not-found."},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("password")
+		sut.passwordMinLength = tc.minLength
+		sut.passwordRequire = tc.require
+		sut.passwordDictionary = tc.dictionary
+		sut.passwordPolicyValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
+
+func TestEstimateEntropy(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"", "0.0"},
+		{"aaaa", "7.7"},
+		{"abcd", "7.7"},
+		{"password", "8.1"},
+		{"xK9#mQ2$", "52.6"},
+	}
+
+	for _, tc := range cases {
+		actual := fmt.Sprintf("%.1f", estimateEntropy(tc.value, commonPasswords))
+		if actual != tc.expected {
+			t.Errorf(fmt.Sprintf("\n value:    %s\n expected: %s\n actual:   %s", tc.value, tc.expected, actual))
+		}
+	}
+}
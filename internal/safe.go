@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+func (v *Validator) safeForValidate() {
+	if v.safeFor == "" {
+		return
+	}
+
+	contexts := splitList(strings.ToLower(v.safeFor))
+	for _, context := range contexts {
+		if _, ok := safeForCheckers[context]; !ok {
+			v.AddArgumentError(fmt.Errorf("--safe-for must be a list of %v", safeForContexts))
+			return
+		}
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	for _, context := range uniqueList(contexts) {
+		if issue := safeForCheckers[context](v.UnmaskedValue); issue != "" {
+			v.AddValidationError(newDetailedError(fmt.Sprintf("must be safe for %s", context), issue))
+		}
+	}
+}
+
+var safeForContexts = []string{"shell", "sql-identifier", "html", "filename", "path-segment"}
+
+// safeForCheckers returns the reason why the value is unsafe in each context, or the empty string if safe.
+var safeForCheckers = map[string]func(string) string{
+	"shell":          unsafeForShell,
+	"sql-identifier": unsafeForSQLIdentifier,
+	"html":           unsafeForHTML,
+	"filename":       unsafeForFilename,
+	"path-segment":   unsafeForPathSegment,
+}
+
+// unsafeForShell allows the same characters as shlex.quote of Python leaves unquoted,
+// and rejects the leading hyphen which commands take as an option.
+func unsafeForShell(value string) string {
+	if issue := firstUnsafeRune(value, func(r rune) bool { return isASCIIWord(r) || strings.ContainsRune("@%+=:,./-", r) }); issue != "" {
+		return issue
+	}
+	if strings.HasPrefix(value, "-") {
+		return `starts with "-", which is taken as an option`
+	}
+	return ""
+}
+
+// unsafeForSQLIdentifier allows unquoted identifiers only, which are the same across databases.
+func unsafeForSQLIdentifier(value string) string {
+	if value[0] >= '0' && value[0] <= '9' {
+		return fmt.Sprintf("starts with %q, which is a digit", value[:1])
+	}
+	return firstUnsafeRune(value, isASCIIWord)
+}
+
+// unsafeForHTML rejects the characters that start tags, character references, or end attribute values.
+func unsafeForHTML(value string) string {
+	return firstUnsafeRune(value, func(r rune) bool {
+		return !strings.ContainsRune("<>&\"'`", r) && (!unicode.IsControl(r) || r == '\t' || r == '\n' || r == '\r')
+	})
+}
+
+// unsafeForFilename rejects the names which are invalid, or refer to another file, on either Unix or Windows.
+func unsafeForFilename(value string) string {
+	if issue := firstUnsafeRune(value, func(r rune) bool { return !strings.ContainsRune(`/\<>:"|?*`, r) && !unicode.IsControl(r) }); issue != "" {
+		return issue
+	}
+	if value == "." || value == ".." {
+		return fmt.Sprintf("is %q, which refers to a directory", value)
+	}
+	if strings.HasSuffix(value, ".") || strings.HasSuffix(value, " ") {
+		return fmt.Sprintf("ends with %q, which is removed on Windows", value[len(value)-1:])
+	}
+	base, _, _ := strings.Cut(value, ".")
+	if slices.Contains(windowsReservedNames, strings.ToUpper(strings.TrimSpace(base))) {
+		return fmt.Sprintf("%q is a reserved name on Windows", base)
+	}
+	if len(value) > 255 {
+		return "is longer than 255 bytes"
+	}
+	return ""
+}
+
+// unsafeForPathSegment allows the characters that can appear in a URL path segment without
+// percent-encoding, and rejects the dot segments which traverse the path.
+// The percent sign is rejected as well, since the decoded value may contain "/" or "..".
+func unsafeForPathSegment(value string) string {
+	if issue := firstUnsafeRune(value, func(r rune) bool { return isASCIIWord(r) || strings.ContainsRune("-.~!$&'()*+,;=:@", r) }); issue != "" {
+		return issue
+	}
+	if value == "." || value == ".." {
+		return fmt.Sprintf("is %q, which traverses the path", value)
+	}
+	return ""
+}
+
+// firstUnsafeRune reports the first character which isn't safe, and its index in runes.
+func firstUnsafeRune(value string, safe func(rune) bool) string {
+	for i, r := range []rune(value) {
+		if !safe(r) {
+			return fmt.Sprintf("contains %q at index %d", string(r), i)
+		}
+	}
+	return ""
+}
+
+// uniqueList removes the duplicates from the list, keeping the first occurrence of each element.
+func uniqueList(list []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, element := range list {
+		if !seen[element] {
+			seen[element] = true
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
+func isASCIIWord(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+var windowsReservedNames = []string{
+	"CON", "PRN", "AUX", "NUL",
+	"COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9",
+	"LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9",
+}
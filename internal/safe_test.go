@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidator_safeForValidate(t *testing.T) {
+	cases := []struct {
+		context  string
+		value    string
+		expected string
+	}{
+		{"shell", "release-1.2.3", ""},
+		{"shell", "user@example.com:/srv/app", ""},
+		{"shell", "foo; rm -rf /", `must be safe for shell, but contains ";" at index 3`},
+		{"shell", "$(whoami)", `must be safe for shell, but contains "$" at index 0`},
+		{"shell", "a`id`", "must be safe for shell, but contains \"`\" at index 1"},
+		{"shell", "foo\nbar", `must be safe for shell, but contains "\n" at index 3`},
+		{"shell", "--upload-pack=touch", `must be safe for shell, but starts with "-", which is taken as an option`},
+		{"sql-identifier", "user_accounts", ""},
+		{"sql-identifier", "users; DROP TABLE users", `must be safe for sql-identifier, but contains ";" at index 5`},
+		{"sql-identifier", `users"`, `must be safe for sql-identifier, but contains "\"" at index 5`},
+		{"sql-identifier", "1st_table", `must be safe for sql-identifier, but starts with "1", which is a digit`},
+		{"html", "Tom & Jerry", `must be safe for html, but contains "&" at index 4`},
+		{"html", "<script>", `must be safe for html, but contains "<" at index 0`},
+		{"html", "line 1\nline 2", ""},
+		{"html", "a\x00b", `must be safe for html, but contains "\x00" at index 1`},
+		{"filename", "report-2024.pdf", ""},
+		{"filename", "レポート.txt", ""},
+		{"filename", "../etc/passwd", `must be safe for filename, but contains "/" at index 2`},
+		{"filename", "..", `must be safe for filename, but is "..", which refers to a directory`},
+		{"filename", "a:b", `must be safe for filename, but contains ":" at index 1`},
+		{"filename", "name.", `must be safe for filename, but ends with ".", which is removed on Windows`},
+		{"filename", "con.txt", `must be safe for filename, but "con" is a reserved name on Windows`},
+		{"filename", strings.Repeat("a", 256), "must be safe for filename, but is longer than 255 bytes"},
+		{"path-segment", "v1.2.3", ""},
+		{"path-segment", "a/b", `must be safe for path-segment, but contains "/" at index 1`},
+		{"path-segment", "%2e%2e", `must be safe for path-segment, but contains "%" at index 0`},
+		{"path-segment", ".", `must be safe for path-segment, but is ".", which traverses the path`},
+		{"path-segment", "a?b#c", `must be safe for path-segment, but contains "?" at index 1`},
+		{"shell,filename", "a/b c", `must be safe for shell, but contains " " at index 3, must be safe for filename, but contains "/" at index 1`},
+		{"shell,html,shell", "a<b", `must be safe for shell, but contains "<" at index 1, must be safe for html, but contains "<" at index 1`},
+		{"HTML", "", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.safeFor = tc.context
+		sut.safeForValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.context)
+	}
+}
+
+func TestValidator_safeForValidate_Masked(t *testing.T) {
+	cases := []struct {
+		annotation string
+		mask       func(*Errors)
+		expected   string
+	}{
+		{"masked", func(e *Errors) { e.MaskValue() }, `Validation error: The specified value "***" is invalid. Issues: must be safe for shell.`},
+		{"redacted", func(e *Errors) { e.Redact("p4$$") }, `Validation error: The specified value "user:***" is invalid. Issues: must be safe for shell.`},
+		{"not redacted", func(e *Errors) { e.Redact("unknown") }, `Validation error: The specified value "user:p4$$" is invalid. Issues: must be safe for shell, but contains "$" at index 7.`},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("user:p4$$")
+		sut.safeFor = "shell"
+		tc.mask(sut.Errors)
+		sut.safeForValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
+
+func TestValidator_safeForValidate_ArgumentError(t *testing.T) {
+	sut := newValidatorSut("value")
+	sut.safeFor = "shell,javascript"
+	sut.safeForValidate()
+
+	expected := "Argument error: --safe-for must be a list of [shell sql-identifier html filename path-segment]."
+	if sut.Errors.Error() != expected {
+		t.Errorf(fmt.Sprintf("\n expected: %s\n actual:   %s", expected, sut.Errors.Error()))
+	}
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func (v *Validator) noSecretsValidate() {
+	if !v.noSecrets || v.UnmaskedValue == "" {
+		return
+	}
+
+	secrets := findSecrets(v.UnmaskedValue)
+	if len(secrets) == 0 {
+		return
+	}
+	// the value must not be printed once it turns out to contain secrets
+	v.MaskValue()
+	for _, secret := range secrets {
+		v.AddValidationError(fmt.Errorf("must not contain secrets, but found %s at index %d", secret.name, secret.index))
+	}
+}
+
+// secretFinding is a detected secret, where the index is the position of the first character in runes.
+type secretFinding struct {
+	name  string
+	index int
+	start int
+	end   int
+}
+
+// findSecrets detects secrets with the pattern catalog, and then random-looking strings
+// not detected by the catalog with the high entropy detector. Findings are sorted by their position.
+func findSecrets(value string) []secretFinding {
+	var findings []secretFinding
+	for _, pattern := range secretPatterns {
+		for _, match := range pattern.regexp.FindAllStringIndex(value, -1) {
+			findings = append(findings, secretFinding{name: pattern.name, start: match[0], end: match[1]})
+		}
+	}
+	for _, match := range highEntropyCandidateRegexp.FindAllStringIndex(value, -1) {
+		overlaps := slices.ContainsFunc(findings, func(f secretFinding) bool { return match[0] < f.end && f.start < match[1] })
+		if !overlaps && isHighEntropy(value[match[0]:match[1]]) {
+			findings = append(findings, secretFinding{name: "high entropy string", start: match[0], end: match[1]})
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b secretFinding) int { return compareInt(a.start, b.start) })
+	for i := range findings {
+		findings[i].index = utf8.RuneCountInString(value[:findings[i].start])
+	}
+	return findings
+}
+
+// isHighEntropy reports whether the string looks like a random token, such as API keys encoded in base64.
+// It requires upper case letters, lower case letters and digits so that hex strings like commit SHAs
+// and UUIDs, and ordinary words are not detected.
+func isHighEntropy(value string) bool {
+	for _, class := range []func(rune) bool{unicode.IsUpper, unicode.IsLower, unicode.IsDigit} {
+		if !strings.ContainsFunc(value, class) {
+			return false
+		}
+	}
+	return shannonEntropy(value) >= highEntropyThreshold(utf8.RuneCountInString(value))
+}
+
+// shannonEntropy returns the Shannon entropy of the characters in bits per character.
+func shannonEntropy(value string) float64 {
+	counts := make(map[rune]int)
+	for _, r := range value {
+		counts[r]++
+	}
+	length := float64(utf8.RuneCountInString(value))
+	entropy := 0.0
+	for _, count := range counts {
+		probability := float64(count) / length
+		entropy -= probability * math.Log2(probability)
+	}
+	return entropy
+}
+
+// highEntropyThreshold returns the bits per character required for the length. It is 4.5 bits commonly used
+// by secret scanners for base64 strings, but lowered for short strings, since n characters have at most log2(n) bits.
+func highEntropyThreshold(length int) float64 {
+	return math.Min(4.5, 0.95*math.Log2(float64(length)))
+}
+
+// highEntropyCandidateRegexp matches base64 strings, where "=" is allowed only as the trailing padding
+// so that the candidate starts after "=" of assignments like "key=<token>".
+var highEntropyCandidateRegexp = regexp.MustCompile(`[A-Za-z0-9+/_-]{20,}={0,2}`)
+
+type secretPattern struct {
+	name   string
+	regexp *regexp.Regexp
+}
+
+// secretPatterns is the catalog of well-known token formats.
+var secretPatterns = []secretPattern{
+	{"AWS access key ID", regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[A-Z0-9]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,255}\b`)},
+	{"GitHub fine-grained personal access token", regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{82}\b`)},
+	{"GitLab personal access token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"Slack webhook URL", regexp.MustCompile(`https://hooks\.slack\.com/(?:services|workflows)/[A-Za-z0-9/_-]+`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"Stripe API key", regexp.MustCompile(`\b(?:sk|rk)_(?:live|test)_[0-9A-Za-z]{24,}\b`)},
+	{"SendGrid API key", regexp.MustCompile(`\bSG\.[A-Za-z0-9_-]{22}\.[A-Za-z0-9_-]{43}\b`)},
+	{"npm access token", regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`)},
+	{"JWT", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)},
+	{"private key", regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----`)},
+	{"credentials in URL", regexp.MustCompile(`\b[A-Za-z][A-Za-z0-9+.-]*://[^\s:/@]+:[^\s/@]+@`)},
+}
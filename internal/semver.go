@@ -0,0 +1,275 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+func (v *Validator) semverPolicyValidate() {
+	if v.semverRange == "" && !v.semverNoPrerelease && v.semverGreaterThan == "" {
+		return
+	}
+
+	var ranges semverRange
+	if v.semverRange != "" {
+		var err error
+		if ranges, err = parseSemverRange(v.semverRange); err != nil {
+			v.AddArgumentError(fmt.Errorf("--semver-range %s", err))
+			return
+		}
+	}
+	var lower *semVersion
+	if v.semverGreaterThan != "" {
+		var err error
+		if lower, err = readSemverArgument(v.semverGreaterThan); err != nil {
+			v.AddArgumentError(fmt.Errorf("--semver-greater-than %s", err))
+			return
+		}
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	// --semver accepts a leading "v" as is.Semver does, so the policies accept it as well
+	version, ok := parseSemver(v.UnmaskedValue, v.semverAllowV || v.semver)
+	if !ok {
+		if _, ok := parseSemver(v.UnmaskedValue, true); ok {
+			v.AddValidationError(fmt.Errorf("must not have a leading v (use --semver-allow-v)"))
+		} else if !v.semver || is.Semver.Validate(v.UnmaskedValue) == nil {
+			// avoid reporting the same issue twice when --semver has already rejected the value
+			v.AddValidationError(is.ErrSemver)
+		}
+		return
+	}
+	if ranges != nil && !ranges.contains(version) {
+		v.AddValidationError(fmt.Errorf("must satisfy %s", v.semverRange))
+	}
+	if v.semverNoPrerelease && len(version.prerelease) > 0 {
+		v.AddValidationError(fmt.Errorf("must not be a prerelease version"))
+	}
+	if lower != nil && version.compare(lower) <= 0 {
+		v.AddValidationError(fmt.Errorf("must be greater than %s", lower))
+	}
+}
+
+// readSemverArgument parses a version given directly, or read from a file when prefixed with "@".
+func readSemverArgument(argument string) (*semVersion, error) {
+	value := argument
+	if file, found := strings.CutPrefix(argument, "@"); found {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the file: %s", file)
+		}
+		value = strings.TrimSpace(string(content))
+	}
+
+	version, ok := parseSemver(value, true)
+	if !ok {
+		return nil, fmt.Errorf("\"%s\" is not a valid semantic version", value)
+	}
+	return version, nil
+}
+
+// semVersion represents a version defined in Semantic Versioning 2.0.0.
+type semVersion struct {
+	major      uint64
+	minor      uint64
+	patch      uint64
+	prerelease []string
+	build      string
+}
+
+func parseSemver(value string, allowV bool) (*semVersion, bool) {
+	if allowV {
+		value = strings.TrimPrefix(value, "v")
+	}
+	matches := semverRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return nil, false
+	}
+
+	version := &semVersion{build: matches[5]}
+	var err error
+	if version.major, err = strconv.ParseUint(matches[1], 10, 64); err != nil {
+		return nil, false
+	}
+	if version.minor, err = strconv.ParseUint(matches[2], 10, 64); err != nil {
+		return nil, false
+	}
+	if version.patch, err = strconv.ParseUint(matches[3], 10, 64); err != nil {
+		return nil, false
+	}
+	if matches[4] != "" {
+		version.prerelease = strings.Split(matches[4], ".")
+	}
+	return version, true
+}
+
+func (s *semVersion) String() string {
+	result := fmt.Sprintf("%d.%d.%d", s.major, s.minor, s.patch)
+	if len(s.prerelease) > 0 {
+		result += "-" + strings.Join(s.prerelease, ".")
+	}
+	if s.build != "" {
+		result += "+" + s.build
+	}
+	return result
+}
+
+// compare returns -1, 0 or +1 following the precedence rules of Semantic Versioning.
+// Build metadata is ignored.
+func (s *semVersion) compare(other *semVersion) int {
+	if c := compareUint(s.major, other.major); c != 0 {
+		return c
+	}
+	if c := compareUint(s.minor, other.minor); c != 0 {
+		return c
+	}
+	if c := compareUint(s.patch, other.patch); c != 0 {
+		return c
+	}
+
+	// a version without prerelease has higher precedence than the one with prerelease
+	if len(s.prerelease) == 0 || len(other.prerelease) == 0 {
+		return compareInt(len(other.prerelease), len(s.prerelease))
+	}
+	for i := 0; i < len(s.prerelease) && i < len(other.prerelease); i++ {
+		if c := comparePrereleaseIdentifier(s.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(s.prerelease), len(other.prerelease))
+}
+
+// comparePrereleaseIdentifier compares numeric identifiers numerically and others lexically,
+// where numeric identifiers always have lower precedence than non-numeric ones.
+func comparePrereleaseIdentifier(a string, b string) int {
+	aNumber, aErr := strconv.ParseUint(a, 10, 64)
+	bNumber, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(aNumber, bNumber)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareUint(a uint64, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareInt(a int, b int) int {
+	return compareUint(uint64(a), uint64(b))
+}
+
+// semverRange is a set of comparator sets joined by "||".
+// A version satisfies the range when it satisfies all comparators of any comparator set.
+type semverRange [][]semverComparator
+
+type semverComparator struct {
+	operator string
+	version  *semVersion
+}
+
+// parseSemverRange parses constraints such as ">=1.4.0 <2.0.0", "^1.2.3 || ~2.0.0".
+// Supported operators are =, !=, >, >=, <, <=, ~ (patch-level changes) and ^ (compatible changes).
+func parseSemverRange(value string) (semverRange, error) {
+	var ranges semverRange
+	for _, set := range strings.Split(value, "||") {
+		var comparators []semverComparator
+		fields := strings.Fields(set)
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			if semverOperatorRegexp.MatchString(field) && i+1 < len(fields) {
+				i++
+				field += fields[i]
+			}
+
+			matches := semverComparatorRegexp.FindStringSubmatch(field)
+			if matches == nil {
+				return nil, fmt.Errorf("\"%s\" is not a valid constraint", field)
+			}
+			version, ok := parseSemver(matches[2], true)
+			if !ok {
+				return nil, fmt.Errorf("\"%s\" is not a valid constraint", field)
+			}
+			comparators = append(comparators, expandSemverComparator(matches[1], version)...)
+		}
+		if len(comparators) == 0 {
+			return nil, fmt.Errorf("\"%s\" is not a valid range", value)
+		}
+		ranges = append(ranges, comparators)
+	}
+	return ranges, nil
+}
+
+func expandSemverComparator(operator string, version *semVersion) []semverComparator {
+	switch operator {
+	case "~":
+		upper := &semVersion{major: version.major, minor: version.minor + 1, prerelease: []string{"0"}}
+		return []semverComparator{{">=", version}, {"<", upper}}
+	case "^":
+		upper := &semVersion{major: version.major + 1, prerelease: []string{"0"}}
+		if version.major == 0 && version.minor == 0 {
+			upper = &semVersion{patch: version.patch + 1, prerelease: []string{"0"}}
+		} else if version.major == 0 {
+			upper = &semVersion{minor: version.minor + 1, prerelease: []string{"0"}}
+		}
+		return []semverComparator{{">=", version}, {"<", upper}}
+	default:
+		return []semverComparator{{operator, version}}
+	}
+}
+
+func (r semverRange) contains(version *semVersion) bool {
+	for _, comparators := range r {
+		if slices.ContainsFunc(comparators, func(c semverComparator) bool { return !c.matches(version) }) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func (c semverComparator) matches(version *semVersion) bool {
+	result := version.compare(c.version)
+	switch c.operator {
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	default:
+		return result == 0
+	}
+}
+
+const semverIdentifier = `(?:0|[1-9][0-9]*|[0-9]*[a-zA-Z-][0-9a-zA-Z-]*)`
+
+var semverRegexp = regexp.MustCompile(`^(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)` +
+	`(?:-(` + semverIdentifier + `(?:\.` + semverIdentifier + `)*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+var semverOperatorRegexp = regexp.MustCompile(`^(=|!=|>|>=|<|<=|~|\^)$`)
+var semverComparatorRegexp = regexp.MustCompile(`^(=|!=|>=|>|<=|<|~|\^)?(.+)$`)
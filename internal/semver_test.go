@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidator_semverPolicyValidate_Range(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "1.4.0", ">=1.4.0 <2.0.0", ""},
+		{"valid2", "1.9.9", ">= 1.4.0 < 2.0.0", ""},
+		{"valid3", "1.2.9", "~1.2.3", ""},
+		{"valid4", "1.9.0", "^1.2.3", ""},
+		{"valid5", "0.2.9", "^0.2.3", ""},
+		{"valid6", "3.0.0", "^1.2.3 || >=3.0.0", ""},
+		{"valid7", "1.0.0", "1.0.0", ""},
+		{"valid8", "1.0.1", "!=1.0.0", ""},
+		{"invalid1", "2.0.0", ">=1.4.0 <2.0.0", "must satisfy >=1.4.0 <2.0.0"},
+		{"invalid2", "2.0.0-rc.1", ">=1.4.0 <2.0.0-0", "must satisfy >=1.4.0 <2.0.0-0"},
+		{"invalid3", "1.3.0", "~1.2.3", "must satisfy ~1.2.3"},
+		{"invalid4", "0.3.0", "^0.2.3", "must satisfy ^0.2.3"},
+		{"invalid5", "0.0.4", "^0.0.3", "must satisfy ^0.0.3"},
+		{"invalid6", "1.4.0-beta", ">=1.4.0", "must satisfy >=1.4.0"},
+		{"invalid7", "v1.4.0", ">=1.4.0", "must not have a leading v (use --semver-allow-v)"},
+		{"invalid8", "1.04.0", ">=1.4.0", "must be a valid semantic version"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.semverRange = tc.argument
+		sut.semverPolicyValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_semverPolicyValidate_NoPrerelease(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		allowV     bool
+		semver     bool
+		expected   string
+	}{
+		{"valid1", "1.2.3", false, false, ""},
+		{"valid2", "1.2.3+build.1", false, false, ""},
+		{"valid3", "v1.2.3", true, false, ""},
+		{"valid4", "v1.2.3", false, true, ""},
+		{"invalid1", "1.2.3-alpha.1", false, false, "must not be a prerelease version"},
+		{"invalid2", "v1.2.3", false, false, "must not have a leading v (use --semver-allow-v)"},
+		{"invalid3", "v1.2.3-alpha.1", false, true, "must not be a prerelease version"},
+		{"invalid4", "1.2", false, false, "must be a valid semantic version"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.semverNoPrerelease = true
+		sut.semverAllowV = tc.allowV
+		sut.semver = tc.semver
+		sut.semverValidate()
+		sut.semverPolicyValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_semverPolicyValidate_GreaterThan(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "VERSION")
+	writeTestFile(t, file, "v1.2.3\n")
+
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "1.2.4", "1.2.3", ""},
+		{"valid2", "1.2.3", "1.2.3-rc.1", ""},
+		{"valid3", "1.2.3-rc.2", "1.2.3-rc.1", ""},
+		{"valid4", "1.2.3-rc.10", "1.2.3-rc.9", ""},
+		{"valid5", "1.2.3-beta", "1.2.3-alpha.1", ""},
+		{"valid6", "1.2.3-alpha.beta", "1.2.3-alpha.1", ""},
+		{"valid7", "2.0.0", "@" + file, ""},
+		{"invalid1", "1.2.3", "1.2.3", "must be greater than 1.2.3"},
+		{"invalid2", "1.2.3+build", "1.2.3", "must be greater than 1.2.3"},
+		{"invalid3", "1.2.3-rc.1", "1.2.3", "must be greater than 1.2.3"},
+		{"invalid4", "1.2.3-alpha", "1.2.3-alpha.1", "must be greater than 1.2.3-alpha.1"},
+		{"invalid5", "1.2.2", "@" + file, "must be greater than 1.2.3"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.semverGreaterThan = tc.argument
+		sut.semverPolicyValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_semverPolicyValidate_ArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation  string
+		rangeArg    string
+		greaterThan string
+		expected    string
+	}{
+		{"invalid_range", ">=1.4", "", "Argument error: --semver-range \">=1.4\" is not a valid constraint."},
+		{"invalid_operator", "=>1.4.0", "", "Argument error: --semver-range \"=>1.4.0\" is not a valid constraint."},
+		{"invalid_version", "", "1.2", "Argument error: --semver-greater-than \"1.2\" is not a valid semantic version."},
+		{"missing_file", "", "@not-found", "Argument error: --semver-greater-than cannot read the file: not-found."},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("1.2.3")
+		sut.semverRange = tc.rangeArg
+		sut.semverGreaterThan = tc.greaterThan
+		sut.semverPolicyValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package internal
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func (v *Validator) spdxValidate() {
+	if !v.spdx && v.spdxAllowed == "" && v.spdxDenied == "" {
+		return
+	}
+
+	allowed, ok := v.spdxLicenseList("--spdx-allowed", v.spdxAllowed)
+	if !ok {
+		return
+	}
+	denied, ok := v.spdxLicenseList("--spdx-denied", v.spdxDenied)
+	if !ok {
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	licenses, ok := parseSPDXExpression(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid SPDX license expression"))
+		return
+	}
+
+	// every operand is checked, so the policy also applies to the alternatives of OR
+	reported := make(map[string]bool)
+	report := func(err error) {
+		if !reported[err.Error()] {
+			reported[err.Error()] = true
+			v.AddValidationError(err)
+		}
+	}
+	for _, license := range licenses {
+		id := strings.ToLower(license.id)
+		if !isSPDXLicenseRef(license.id) && !spdxLicenses[id] {
+			report(fmt.Errorf("\"%s\" is not a valid SPDX license identifier", license.id))
+		} else if allowed != nil && !allowed[id] {
+			report(fmt.Errorf("\"%s\" is not an allowed license", license.id))
+		} else if denied[id] {
+			report(fmt.Errorf("\"%s\" is a denied license", license.id))
+		}
+		if license.exception != "" && !spdxExceptions[strings.ToLower(license.exception)] {
+			report(fmt.Errorf("\"%s\" is not a valid SPDX license exception", license.exception))
+		}
+	}
+}
+
+// spdxLicenseList parses the comma-separated license identifiers of the flag into a set of lower case identifiers.
+func (v *Validator) spdxLicenseList(flag string, list string) (map[string]bool, bool) {
+	if list == "" {
+		return nil, true
+	}
+	result := make(map[string]bool)
+	for _, id := range splitList(list) {
+		if !isSPDXLicenseRef(id) && !spdxLicenses[strings.ToLower(id)] {
+			v.AddArgumentError(fmt.Errorf("%s \"%s\" is not a valid SPDX license identifier", flag, id))
+			return nil, false
+		}
+		result[strings.ToLower(id)] = true
+	}
+	return result, true
+}
+
+// spdxLicense is an operand of an SPDX license expression, such as "GPL-2.0+ WITH Classpath-exception-2.0".
+type spdxLicense struct {
+	id        string
+	orLater   bool
+	exception string
+}
+
+// parseSPDXExpression parses the license expression defined in the SPDX specification, Annex D,
+// and returns its operands. WITH binds more tightly than AND, and AND binds more tightly than OR.
+func parseSPDXExpression(value string) ([]spdxLicense, bool) {
+	parser := &spdxParser{tokens: tokenizeSPDXExpression(value)}
+	if !parser.parseOr() || parser.position != len(parser.tokens) {
+		return nil, false
+	}
+	return parser.licenses, true
+}
+
+func tokenizeSPDXExpression(value string) []string {
+	var tokens []string
+	for _, field := range strings.Fields(value) {
+		for field != "" {
+			i := strings.IndexAny(field, "()")
+			switch {
+			case i < 0:
+				tokens, field = append(tokens, field), ""
+			case i == 0:
+				tokens, field = append(tokens, field[:1]), field[1:]
+			default:
+				tokens, field = append(tokens, field[:i]), field[i:]
+			}
+		}
+	}
+	return tokens
+}
+
+type spdxParser struct {
+	tokens   []string
+	position int
+	licenses []spdxLicense
+}
+
+func (p *spdxParser) parseOr() bool {
+	if !p.parseAnd() {
+		return false
+	}
+	for p.accept("OR") {
+		if !p.parseAnd() {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *spdxParser) parseAnd() bool {
+	if !p.parseOperand() {
+		return false
+	}
+	for p.accept("AND") {
+		if !p.parseOperand() {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *spdxParser) parseOperand() bool {
+	if p.accept("(") {
+		return p.parseOr() && p.accept(")")
+	}
+
+	token, ok := p.next()
+	if !ok {
+		return false
+	}
+	id, orLater := strings.CutSuffix(token, "+")
+	if !spdxLicenseRefRegexp.MatchString(id) && (!spdxIDStringRegexp.MatchString(id) || isSPDXOperator(id)) {
+		return false
+	}
+	if orLater && isSPDXLicenseRef(id) {
+		return false
+	}
+
+	license := spdxLicense{id: id, orLater: orLater}
+	if p.accept("WITH") {
+		exception, ok := p.next()
+		if !ok || !spdxIDStringRegexp.MatchString(exception) || isSPDXOperator(exception) {
+			return false
+		}
+		license.exception = exception
+	}
+	p.licenses = append(p.licenses, license)
+	return true
+}
+
+// accept consumes the next token when it is the expected one.
+// Operators are matched in either upper or lower case.
+func (p *spdxParser) accept(expected string) bool {
+	if p.position < len(p.tokens) && (p.tokens[p.position] == expected || p.tokens[p.position] == strings.ToLower(expected)) {
+		p.position++
+		return true
+	}
+	return false
+}
+
+func (p *spdxParser) next() (string, bool) {
+	if p.position >= len(p.tokens) || p.tokens[p.position] == "(" || p.tokens[p.position] == ")" {
+		return "", false
+	}
+	p.position++
+	return p.tokens[p.position-1], true
+}
+
+func isSPDXOperator(value string) bool {
+	switch value {
+	case "AND", "and", "OR", "or", "WITH", "with":
+		return true
+	default:
+		return false
+	}
+}
+
+// isSPDXLicenseRef reports whether the value is a user defined license reference,
+// such as "LicenseRef-Proprietary" or "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2".
+func isSPDXLicenseRef(value string) bool {
+	return spdxLicenseRefRegexp.MatchString(value)
+}
+
+// The license and exception identifiers, including the deprecated ones, are derived from the SPDX License List
+// at the commit 230a95b of https://github.com/spdx/license-list-data released on 2026-04-28.
+// To refresh them, take licenseId and licenseExceptionId of json/licenses.json and json/exceptions.json
+// in the latest release, sort them case-insensitively, and update the version above.
+// See https://spdx.org/licenses/ for details.
+//
+//go:embed data/spdx-licenses.txt
+var spdxLicensesData string
+
+//go:embed data/spdx-exceptions.txt
+var spdxExceptionsData string
+
+var spdxLicenses = newSPDXIdentifierSet(spdxLicensesData)
+var spdxExceptions = newSPDXIdentifierSet(spdxExceptionsData)
+
+// newSPDXIdentifierSet returns lower case identifiers because SPDX identifiers are matched case-insensitively.
+func newSPDXIdentifierSet(data string) map[string]bool {
+	result := make(map[string]bool)
+	for _, id := range strings.Fields(data) {
+		result[strings.ToLower(id)] = true
+	}
+	return result
+}
+
+var spdxIDStringRegexp = regexp.MustCompile(`^[A-Za-z0-9.-]+$`)
+var spdxLicenseRefRegexp = regexp.MustCompile(`^(DocumentRef-[A-Za-z0-9.-]+:)?LicenseRef-[A-Za-z0-9.-]+$`)
@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidator_spdxValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		expected   string
+	}{
+		{"identifier", "MIT", ""},
+		{"case_insensitive", "apache-2.0", ""},
+		{"or_later", "GPL-2.0+", ""},
+		{"recent_identifier", "Unicode-3.0", ""},
+		{"or", "MIT OR Apache-2.0", ""},
+		{"with", "GPL-2.0-only WITH Classpath-exception-2.0", ""},
+		{"parentheses", "(MIT OR Apache-2.0) AND (BSD-3-Clause OR ISC)", ""},
+		{"nested", "((MIT))", ""},
+		{"lower_case_operators", "mit or apache-2.0", ""},
+		{"license_ref", "LicenseRef-Proprietary", ""},
+		{"document_ref", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", ""},
+		{"empty", "", ""},
+		{"unknown", "Foo-1.0", "\"Foo-1.0\" is not a valid SPDX license identifier"},
+		{"unknown_operand", "MIT OR Foo-1.0 AND Bar", "\"Foo-1.0\" is not a valid SPDX license identifier, \"Bar\" is not a valid SPDX license identifier"},
+		{"unknown_exception", "GPL-2.0-only WITH Foo-exception", "\"Foo-exception\" is not a valid SPDX license exception"},
+		{"missing_operand", "MIT OR", "must be a valid SPDX license expression"},
+		{"unbalanced", "(MIT OR Apache-2.0", "must be a valid SPDX license expression"},
+		{"with_on_compound", "(MIT OR Apache-2.0) WITH LLVM-exception", "must be a valid SPDX license expression"},
+		{"mixed_case_operator", "MIT Or Apache-2.0", "must be a valid SPDX license expression"},
+		{"license_ref_or_later", "LicenseRef-Foo+", "must be a valid SPDX license expression"},
+		{"invalid_character", "MIT/X11", "must be a valid SPDX license expression"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.spdx = true
+		sut.spdxValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_spdxValidate_Policy(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		allowed    string
+		denied     string
+		expected   string
+	}{
+		{"allowed", "MIT OR Apache-2.0", "MIT, Apache-2.0, BSD-3-Clause", "", ""},
+		{"allowed_case_insensitive", "mit", "MIT", "", ""},
+		{"allowed_or_later", "GPL-2.0+", "GPL-2.0", "", ""},
+		{"not_allowed", "MIT OR GPL-3.0-only", "MIT,Apache-2.0", "", "\"GPL-3.0-only\" is not an allowed license"},
+		{"not_allowed_license_ref", "LicenseRef-Proprietary", "MIT", "", "\"LicenseRef-Proprietary\" is not an allowed license"},
+		{"not_denied", "MIT AND Apache-2.0", "", "GPL-3.0-only,AGPL-3.0-only", ""},
+		{"denied", "Apache-2.0 OR AGPL-3.0-only", "", "GPL-3.0-only,AGPL-3.0-only", "\"AGPL-3.0-only\" is a denied license"},
+		{"denied_reported_once", "GPL-3.0-only OR GPL-3.0-only WITH GCC-exception-3.1", "", "GPL-3.0-only", "\"GPL-3.0-only\" is a denied license"},
+		{"allowed_and_denied", "MIT OR LGPL-2.1-only", "MIT,LGPL-2.1-only", "LGPL-2.1-only", "\"LGPL-2.1-only\" is a denied license"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.spdxAllowed = tc.allowed
+		sut.spdxDenied = tc.denied
+		sut.spdxValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_spdxValidate_ArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation string
+		allowed    string
+		denied     string
+		expected   string
+	}{
+		{"invalid_allowed", "MIT,Foo", "", "Argument error: --spdx-allowed \"Foo\" is not a valid SPDX license identifier."},
+		{"invalid_denied", "", "GPL", "Argument error: --spdx-denied \"GPL\" is not a valid SPDX license identifier."},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("MIT")
+		sut.spdxAllowed = tc.allowed
+		sut.spdxDenied = tc.denied
+		sut.spdxValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package internal
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+func (v *Validator) noInvisibleValidate() {
+	if !v.noInvisible {
+		return
+	}
+	if i, r, ok := findRune(v.UnmaskedValue, isInvisible); ok {
+		v.AddValidationError(newDetailedError("must not contain invisible characters", fmt.Sprintf("found %s at index %d", codePoint(r), i)))
+	}
+}
+
+func (v *Validator) noBidiControlsValidate() {
+	if !v.noBidiControls {
+		return
+	}
+	if i, r, ok := findRune(v.UnmaskedValue, isBidiControl); ok {
+		v.AddValidationError(newDetailedError("must not contain bidirectional control characters", fmt.Sprintf("found %s at index %d", codePoint(r), i)))
+	}
+}
+
+func (v *Validator) noConfusablesValidate() {
+	if !v.noConfusables {
+		return
+	}
+	if i, r, ok := findRune(v.UnmaskedValue, isConfusable); ok {
+		detail := fmt.Sprintf("found %s at index %d, which looks like %q", codePoint(r), i, confusables[r])
+		v.AddValidationError(newDetailedError("must not contain confusable characters", detail))
+	}
+}
+
+func (v *Validator) noMixedScriptsValidate() {
+	if !v.noMixedScripts {
+		return
+	}
+
+	var scripts []string
+	for i, r := range []rune(v.UnmaskedValue) {
+		script := scriptOf(r)
+		if script == "" || slices.Contains(scripts, script) {
+			continue
+		}
+		if len(scripts) > 0 && !isAllowedScriptMix(append(scripts, script)) {
+			detail := fmt.Sprintf("found %s %s at index %d after %s", script, codePoint(r), i, strings.Join(scripts, ", "))
+			v.AddValidationError(newDetailedError("must not mix scripts", detail))
+			return
+		}
+		scripts = append(scripts, script)
+	}
+}
+
+// findRune returns the first character satisfying the condition, and its index in runes.
+func findRune(value string, condition func(rune) bool) (int, rune, bool) {
+	for i, r := range []rune(value) {
+		if condition(r) {
+			return i, r, true
+		}
+	}
+	return 0, 0, false
+}
+
+func codePoint(r rune) string {
+	return fmt.Sprintf("U+%04X", r)
+}
+
+// isInvisible reports whether the character renders as nothing, such as zero-width and format characters,
+// control characters other than tab and newlines, variation selectors, and Hangul fillers.
+// The bidirectional control characters are left to isBidiControl.
+func isInvisible(r rune) bool {
+	if isBidiControl(r) || r == '\t' || r == '\n' || r == '\r' {
+		return false
+	}
+	return unicode.In(r, unicode.Cf, unicode.Cc, unicode.Variation_Selector) ||
+		r == '\u034F' || r == '\u115F' || r == '\u1160' || r == '\u3164' || r == '\uFFA0'
+}
+
+// isBidiControl reports whether the character is one of the explicit bidirectional formatting characters,
+// which reorder the text as displayed and are abused by the Trojan Source attacks.
+func isBidiControl(r rune) bool {
+	return r == '\u061C' || r == '\u200E' || r == '\u200F' || ('\u202A' <= r && r <= '\u202E') || ('\u2066' <= r && r <= '\u2069')
+}
+
+// isConfusable reports whether the non-ASCII character looks like ASCII characters.
+func isConfusable(r rune) bool {
+	_, ok := confusables[r]
+	return ok
+}
+
+// scriptOf returns the script of the character, or the empty string for the Common and Inherited scripts
+// which are shared across scripts, such as digits, punctuation, and combining marks.
+func scriptOf(r rune) string {
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return ""
+	}
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// isAllowedScriptMix reports whether the scripts are commonly used together, following the
+// "Highly Restrictive" level of Unicode Technical Standard #39, such as Latin, Han and Hiragana in Japanese.
+func isAllowedScriptMix(scripts []string) bool {
+	for _, allowed := range allowedScriptMixes {
+		if !slices.ContainsFunc(scripts, func(script string) bool { return !slices.Contains(allowed, script) }) {
+			return true
+		}
+	}
+	return false
+}
+
+var allowedScriptMixes = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+// confusables maps the non-ASCII characters to the ASCII strings they look like, extracted from
+// the confusables.txt of Unicode Technical Standard #39. Each line is the code point of the character,
+// ";", and the code points of the string.
+//
+//go:embed data/confusables.txt
+var confusablesData string
+
+var confusables = newConfusables(confusablesData)
+
+func newConfusables(data string) map[rune]string {
+	confusables := make(map[rune]string)
+	for _, line := range strings.Split(strings.TrimSpace(data), "\n") {
+		source, target, _ := strings.Cut(line, ";")
+		var runes []rune
+		for _, field := range strings.Fields(target) {
+			runes = append(runes, parseCodePoint(field))
+		}
+		confusables[parseCodePoint(strings.TrimSpace(source))] = string(runes)
+	}
+	return confusables
+}
+
+func parseCodePoint(hex string) rune {
+	parsed, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		panic(fmt.Sprintf("invalid code point: %s", hex))
+	}
+	return rune(parsed)
+}
@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidator_noInvisibleValidate(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"admin", ""},
+		{"tab\tand\nnewline", ""},
+		{"ad\u200Bmin", "must not contain invisible characters, but found U+200B at index 2"},
+		{"admin\uFEFF", "must not contain invisible characters, but found U+FEFF at index 5"},
+		{"パス\u2060", "must not contain invisible characters, but found U+2060 at index 2"},
+		{"a\x00b", "must not contain invisible characters, but found U+0000 at index 1"},
+		{"\u3164name", "must not contain invisible characters, but found U+3164 at index 0"},
+		{"text\uFE0F", "must not contain invisible characters, but found U+FE0F at index 4"},
+		{"rtl\u202E", ""},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.noInvisible = true
+		sut.noInvisibleValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, "--no-invisible")
+	}
+}
+
+func TestValidator_noBidiControlsValidate(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"access_level", ""},
+		{"مرحبا", ""},
+		{"user\u202E \u2066// admin\u2069 \u2066", "must not contain bidirectional control characters, but found U+202E at index 4"},
+		{"\u2067abc", "must not contain bidirectional control characters, but found U+2067 at index 0"},
+		{"abc\u200F", "must not contain bidirectional control characters, but found U+200F at index 3"},
+		{"abc\u061C", "must not contain bidirectional control characters, but found U+061C at index 3"},
+		{"zero\u200Bwidth", ""},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.noBidiControls = true
+		sut.noBidiControlsValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, "--no-bidi-controls")
+	}
+}
+
+func TestValidator_noConfusablesValidate(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"paypal", ""},
+		{"日本語", ""},
+		{"pаypal", `must not contain confusable characters, but found U+0430 at index 1, which looks like "a"`},
+		{"gοogle", `must not contain confusable characters, but found U+03BF at index 1, which looks like "o"`},
+		{"ａdmin", `must not contain confusable characters, but found U+FF41 at index 0, which looks like "a"`},
+		{"Æsir", `must not contain confusable characters, but found U+00C6 at index 0, which looks like "AE"`},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.noConfusables = true
+		sut.noConfusablesValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, "--no-confusables")
+	}
+}
+
+func TestValidator_noMixedScriptsValidate(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"paypal", ""},
+		{"привет", ""},
+		{"release-2024.1", ""},
+		{"東京タワーとTokyo", ""},
+		{"서울Seoul漢字", ""},
+		{"pаypal", "must not mix scripts, but found Cyrillic U+0430 at index 1 after Latin"},
+		{"αβγ abc", "must not mix scripts, but found Latin U+0061 at index 4 after Greek"},
+		{"東京タワー서울", "must not mix scripts, but found Hangul U+C11C at index 5 after Han, Katakana"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.noMixedScripts = true
+		sut.noMixedScriptsValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, "--no-mixed-scripts")
+	}
+}
+
+func TestValidator_spoofingValidate_Masked(t *testing.T) {
+	cases := []struct {
+		annotation string
+		validate   func(*Validator)
+		expected   string
+	}{
+		{"--no-invisible", func(v *Validator) { v.noInvisible = true; v.noInvisibleValidate() }, "must not contain invisible characters"},
+		{"--no-bidi-controls", func(v *Validator) { v.noBidiControls = true; v.noBidiControlsValidate() }, "must not contain bidirectional control characters"},
+		{"--no-confusables", func(v *Validator) { v.noConfusables = true; v.noConfusablesValidate() }, "must not contain confusable characters"},
+		{"--no-mixed-scripts", func(v *Validator) { v.noMixedScripts = true; v.noMixedScriptsValidate() }, "must not mix scripts"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("p\u0430ss\u200B\u202E")
+		sut.MaskValue()
+		tc.validate(sut)
+
+		expected := fmt.Sprintf("Validation error: The specified value \"***\" is invalid. Issues: %s.", tc.expected)
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, expected, sut.Errors.Error()))
+		}
+	}
+}
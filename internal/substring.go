@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/cases"
+)
+
+// substringValidate validates the substrings literally, so that regular expression metacharacters need no escaping.
+// The any-of variants take a comma-separated list, where spaces are kept as a part of the substrings.
+func (v *Validator) substringValidate() {
+	rules := []struct {
+		flag     string
+		argument string
+		list     bool
+		match    func(string, string) bool
+		message  string
+	}{
+		{"--starts-with", v.startsWith, false, strings.HasPrefix, "must start with"},
+		{"--starts-with-any", v.startsWithAny, true, strings.HasPrefix, "must start with"},
+		{"--ends-with", v.endsWith, false, strings.HasSuffix, "must end with"},
+		{"--ends-with-any", v.endsWithAny, true, strings.HasSuffix, "must end with"},
+		{"--contains", v.contains, false, strings.Contains, "must contain"},
+		{"--contains-any", v.containsAny, true, strings.Contains, "must contain"},
+	}
+
+	fold := func(s string) string { return s }
+	if v.ignoreCase {
+		fold = cases.Fold().String
+	}
+	value := fold(v.UnmaskedValue)
+
+	for _, rule := range rules {
+		if rule.argument == "" {
+			continue
+		}
+		substrings, ok := v.substringList(rule.flag, rule.argument, rule.list)
+		if !ok || value == "" {
+			continue
+		}
+		if slices.ContainsFunc(substrings, func(substring string) bool { return rule.match(value, fold(substring)) }) {
+			continue
+		}
+		if rule.list {
+			v.AddValidationError(fmt.Errorf("%s one of %q", rule.message, substrings))
+		} else {
+			v.AddValidationError(fmt.Errorf("%s %q", rule.message, substrings[0]))
+		}
+	}
+
+	// the denied substrings are reported individually, since users need to know which one was found
+	var denied []string
+	if v.notContains != "" {
+		denied = append(denied, v.notContains)
+	}
+	if v.notContainsAny != "" {
+		if substrings, ok := v.substringList("--not-contains-any", v.notContainsAny, true); ok {
+			denied = append(denied, substrings...)
+		}
+	}
+	if value == "" {
+		return
+	}
+	for _, substring := range uniqueList(denied) {
+		if strings.Contains(value, fold(substring)) {
+			v.AddValidationError(fmt.Errorf("must not contain %q", substring))
+		}
+	}
+}
+
+func (v *Validator) substringList(flag string, argument string, list bool) ([]string, bool) {
+	if !list {
+		return []string{argument}, true
+	}
+	substrings := strings.Split(argument, ",")
+	if slices.Contains(substrings, "") {
+		v.AddArgumentError(fmt.Errorf("%s must not contain empty substrings", flag))
+		return nil, false
+	}
+	return substrings, true
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidator_substringValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		sut        func(v *Validator)
+		expected   string
+	}{
+		{"starts-with", "arn:aws:s3:::bucket", func(v *Validator) { v.startsWith = "arn:" }, ""},
+		{"starts-with invalid", "urn:isbn:0451450523", func(v *Validator) { v.startsWith = "arn:" }, `must start with "arn:"`},
+		{"starts-with literal", "a.b", func(v *Validator) { v.startsWith = "." }, `must start with "."`},
+		{"starts-with-any", "refs/tags/v1.0.0", func(v *Validator) { v.startsWithAny = "refs/heads/,refs/tags/" }, ""},
+		{"starts-with-any invalid", "refs/pull/1", func(v *Validator) { v.startsWithAny = "refs/heads/,refs/tags/" }, `must start with one of ["refs/heads/" "refs/tags/"]`},
+		{"ends-with", "api.example.com", func(v *Validator) { v.endsWith = ".example.com" }, ""},
+		{"ends-with invalid", "api.example.com.evil", func(v *Validator) { v.endsWith = ".example.com" }, `must end with ".example.com"`},
+		{"ends-with-any", "image.png", func(v *Validator) { v.endsWithAny = ".png,.jpg" }, ""},
+		{"ends-with-any invalid", "image.gif", func(v *Validator) { v.endsWithAny = ".png,.jpg" }, `must end with one of [".png" ".jpg"]`},
+		{"contains", "fix(scope): [skip ci]", func(v *Validator) { v.contains = "[skip ci]" }, ""},
+		{"contains invalid", "fix(scope): skip ci", func(v *Validator) { v.contains = "[skip ci]" }, `must contain "[skip ci]"`},
+		{"contains-any", "Closes #123", func(v *Validator) { v.containsAny = "Fixes #,Closes #" }, ""},
+		{"contains-any invalid", "Refs #123", func(v *Validator) { v.containsAny = "Fixes #,Closes #" }, `must contain one of ["Fixes #" "Closes #"]`},
+		{"not-contains", "path/to/file", func(v *Validator) { v.notContains = ".." }, ""},
+		{"not-contains invalid", "path/../file", func(v *Validator) { v.notContains = ".." }, `must not contain ".."`},
+		{"not-contains-any", "release", func(v *Validator) { v.notContainsAny = "WIP,TODO" }, ""},
+		{"not-contains-any invalid", "WIP: TODO", func(v *Validator) { v.notContainsAny = "WIP,TODO,FIXME" }, `must not contain "WIP", must not contain "TODO"`},
+		{"not-contains-any duplicates", "WIP: TODO", func(v *Validator) { v.notContainsAny = "WIP,TODO,WIP"; v.notContains = "TODO" }, `must not contain "TODO", must not contain "WIP"`},
+		{"ignore-case", "ARN:aws:s3:::bucket", func(v *Validator) { v.startsWith = "arn:"; v.ignoreCase = true }, ""},
+		{"ignore-case unicode", "STRASSE", func(v *Validator) { v.endsWith = "straße"; v.ignoreCase = true }, ""},
+		{"ignore-case not-contains", "Work In Progress", func(v *Validator) { v.notContains = "work in"; v.ignoreCase = true }, `must not contain "work in"`},
+		{"case-sensitive", "ARN:aws:s3:::bucket", func(v *Validator) { v.startsWith = "arn:" }, `must start with "arn:"`},
+		{"multiple", "test.txt", func(v *Validator) { v.startsWith = "prod"; v.endsWith = ".json" }, `must start with "prod", must end with ".json"`},
+		{"empty", "", func(v *Validator) { v.startsWith = "arn:"; v.contains = "x" }, ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		tc.sut(sut)
+		sut.substringValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_substringValidate_ArgumentError(t *testing.T) {
+	sut := newValidatorSut("value")
+	sut.containsAny = "a,,b"
+	sut.substringValidate()
+
+	expected := "Argument error: --contains-any must not contain empty substrings."
+	if sut.Errors.Error() != expected {
+		t.Errorf(fmt.Sprintf("\n expected: %s\n actual:   %s", expected, sut.Errors.Error()))
+	}
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Transformer normalizes the value before validation, in the order of trimming, collapsing spaces,
+// stripping the prefix, Unicode normalization, and case conversion.
+type Transformer struct {
+	trim            bool
+	collapseSpaces  bool
+	stripPrefix     string
+	toNormalization string
+	lower           bool
+	upper           bool
+}
+
+func (t *Transformer) Transform(value string) (string, error) {
+	if t.lower && t.upper {
+		return value, fmt.Errorf("--lower and --upper cannot be specified together")
+	}
+
+	if t.trim {
+		value = strings.TrimSpace(value)
+	}
+	if t.collapseSpaces {
+		value = collapseSpaces(value)
+	}
+	if t.stripPrefix != "" {
+		value = strings.TrimPrefix(value, t.stripPrefix)
+	}
+	if t.toNormalization != "" {
+		forms := map[string]norm.Form{"nfc": norm.NFC, "nfd": norm.NFD, "nfkc": norm.NFKC, "nfkd": norm.NFKD}
+		form, ok := forms[strings.ToLower(t.toNormalization)]
+		if !ok {
+			return value, fmt.Errorf("--to-normalization must be one of [nfc nfd nfkc nfkd]")
+		}
+		value = form.String(value)
+	}
+	if t.lower {
+		value = strings.ToLower(value)
+	}
+	if t.upper {
+		value = strings.ToUpper(value)
+	}
+	return value, nil
+}
+
+// collapseSpaces replaces each run of whitespace characters, including newlines, with a single space.
+func collapseSpaces(value string) string {
+	var builder strings.Builder
+	space := false
+	for _, r := range value {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space {
+			builder.WriteRune(' ')
+			space = false
+		}
+		builder.WriteRune(r)
+	}
+	if space {
+		builder.WriteRune(' ')
+	}
+	return builder.String()
+}
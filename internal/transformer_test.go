@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTransformer_Transform(t *testing.T) {
+	cases := []struct {
+		annotation  string
+		value       string
+		transformer *Transformer
+		expected    string
+	}{
+		{"none", " Value \n", &Transformer{}, " Value \n"},
+		{"trim", " \tvalue\n", &Transformer{trim: true}, "value"},
+		{"collapse-spaces", "a  b\t\nc ", &Transformer{collapseSpaces: true}, "a b c "},
+		{"trim and collapse-spaces", "  a  b  ", &Transformer{trim: true, collapseSpaces: true}, "a b"},
+		{"strip-prefix", "v1.2.3", &Transformer{stripPrefix: "v"}, "1.2.3"},
+		{"strip-prefix once", "vv1.2.3", &Transformer{stripPrefix: "v"}, "v1.2.3"},
+		{"strip-prefix after trim", " v1.2.3\n", &Transformer{trim: true, stripPrefix: "v"}, "1.2.3"},
+		{"to-normalization nfc", "café", &Transformer{toNormalization: "nfc"}, "café"},
+		{"to-normalization nfkc", "ＡＢ", &Transformer{toNormalization: "NFKC"}, "AB"},
+		{"lower", "MixedCase", &Transformer{lower: true}, "mixedcase"},
+		{"upper", "MixedCase", &Transformer{upper: true}, "MIXEDCASE"},
+		{"all", "  V1.2.3-RC  ", &Transformer{trim: true, stripPrefix: "V", lower: true}, "1.2.3-rc"},
+	}
+
+	for _, tc := range cases {
+		actual, err := tc.transformer.Transform(tc.value)
+
+		format := "\n annotation: %s\n expected:   %q\n actual:     %q, %v"
+		if err != nil || actual != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, actual, err))
+		}
+	}
+}
+
+func TestTransformer_Transform_ArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation  string
+		transformer *Transformer
+		expected    string
+	}{
+		{"to-normalization", &Transformer{toNormalization: "nfx"}, "--to-normalization must be one of [nfc nfd nfkc nfkd]"},
+		{"lower and upper", &Transformer{lower: true, upper: true}, "--lower and --upper cannot be specified together"},
+	}
+
+	for _, tc := range cases {
+		_, err := tc.transformer.Transform("value")
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %v"
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, err))
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (v *Validator) uriValidate() {
+	if !v.uri {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isURI, "must be a valid URI"))
+}
+
+func (v *Validator) urlReferenceValidate() {
+	if !v.urlReference {
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(isURLReference, "must be a valid URL reference"))
+}
+
+func (v *Validator) urnValidate() {
+	if !v.urn {
+		return
+	}
+	v.wrapValidate(validation.Match(urnRegexp).Error("must be a valid URN"))
+}
+
+func (v *Validator) urlPolicyValidate() {
+	if !v.hasURLPolicy() {
+		return
+	}
+
+	queries := []string{"", "required", "forbidden"}
+	if !slices.Contains(queries, v.urlQuery) {
+		v.AddArgumentError(fmt.Errorf("--url-query must be one of %v", queries[1:]))
+		return
+	}
+	if !slices.Contains(queries, v.urlFragment) {
+		v.AddArgumentError(fmt.Errorf("--url-fragment must be one of %v", queries[1:]))
+		return
+	}
+	if !v.validHostPatterns("--url-allowed-hosts", v.urlAllowedHosts) || !v.validHostPatterns("--url-denied-hosts", v.urlDeniedHosts) {
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	u, err := url.Parse(v.UnmaskedValue)
+	if err != nil || !isURLReference(v.UnmaskedValue) {
+		v.AddValidationError(fmt.Errorf("must be a valid URL reference"))
+		return
+	}
+	// the password must not appear in error messages, even when --url-forbid-userinfo rejects it
+	for _, password := range urlPasswords(v.UnmaskedValue, u) {
+		v.Redact(password)
+	}
+
+	if v.urlSchemes != "" {
+		schemes := splitList(strings.ToLower(v.urlSchemes))
+		if !slices.Contains(schemes, strings.ToLower(u.Scheme)) {
+			v.AddValidationError(fmt.Errorf("scheme must be one of %v", schemes))
+		}
+	}
+	if v.urlRequireHTTPS && !strings.EqualFold(u.Scheme, "https") {
+		v.AddValidationError(fmt.Errorf("must use the https scheme"))
+	}
+	if v.urlAllowedHosts != "" {
+		hosts := splitList(v.urlAllowedHosts)
+		if !matchHostPatterns(hosts, u.Hostname()) {
+			v.AddValidationError(fmt.Errorf("host must match one of %v", hosts))
+		}
+	}
+	if v.urlDeniedHosts != "" {
+		hosts := splitList(v.urlDeniedHosts)
+		if matchHostPatterns(hosts, u.Hostname()) {
+			v.AddValidationError(fmt.Errorf("host must not match any of %v", hosts))
+		}
+	}
+	if v.urlForbidUserinfo && u.User != nil {
+		v.AddValidationError(fmt.Errorf("must not contain user information"))
+	}
+	if v.urlForbidIPHost && isIP(u.Hostname()) {
+		v.AddValidationError(fmt.Errorf("host must not be an IP address"))
+	}
+
+	hasQuery := u.RawQuery != "" || u.ForceQuery
+	if v.urlQuery == "required" && !hasQuery {
+		v.AddValidationError(fmt.Errorf("must contain a query"))
+	} else if v.urlQuery == "forbidden" && hasQuery {
+		v.AddValidationError(fmt.Errorf("must not contain a query"))
+	}
+
+	hasFragment := strings.Contains(v.UnmaskedValue, "#")
+	if v.urlFragment == "required" && !hasFragment {
+		v.AddValidationError(fmt.Errorf("must contain a fragment"))
+	} else if v.urlFragment == "forbidden" && hasFragment {
+		v.AddValidationError(fmt.Errorf("must not contain a fragment"))
+	}
+}
+
+func (v *Validator) hasURLPolicy() bool {
+	return v.urlSchemes != "" || v.urlRequireHTTPS || v.urlAllowedHosts != "" || v.urlDeniedHosts != "" ||
+		v.urlForbidUserinfo || v.urlForbidIPHost || v.urlQuery != "" || v.urlFragment != ""
+}
+
+// urlPasswords returns the password of the URL both as decoded and as written, which may be percent-encoded.
+func urlPasswords(value string, u *url.URL) []string {
+	password, ok := u.User.Password()
+	if !ok {
+		return nil
+	}
+	_, authority, _ := strings.Cut(value, "//")
+	if i := strings.IndexAny(authority, "/?#"); i >= 0 {
+		authority = authority[:i]
+	}
+	userinfo, _, _ := cutLast(authority, "@")
+	_, raw, _ := strings.Cut(userinfo, ":")
+	return []string{password, raw}
+}
+
+func isURI(value string) bool {
+	if !isURLReference(value) {
+		return false
+	}
+	scheme, _, found := strings.Cut(value, ":")
+	return found && uriSchemeRegexp.MatchString(scheme)
+}
+
+func isURLReference(value string) bool {
+	if strings.IndexFunc(value, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		return false
+	}
+	_, err := url.Parse(value)
+	return err == nil
+}
+
+// matchHostPatterns reports whether host matches any of patterns.
+// A pattern starting with "*." matches any subdomain, but not the domain itself.
+func matchHostPatterns(patterns []string, host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// validHostPatterns reports whether the comma-separated patterns use the wildcard only as the "*." prefix,
+// since the other forms like "*example.com" would match unrelated domains such as evilexample.com.
+func (v *Validator) validHostPatterns(flag string, patterns string) bool {
+	if patterns == "" {
+		return true
+	}
+	for _, pattern := range splitList(patterns) {
+		if strings.Contains(strings.TrimPrefix(pattern, "*."), "*") {
+			v.AddArgumentError(fmt.Errorf("%s \"%s\" is not a valid host pattern, use *.example.com for subdomains", flag, pattern))
+			return false
+		}
+	}
+	return true
+}
+
+func splitList(value string) []string {
+	items := strings.Split(value, ",")
+	for i, item := range items {
+		items[i] = strings.TrimSpace(item)
+	}
+	return items
+}
+
+var uriSchemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*$`)
+
+// urnRegexp follows the URN syntax defined in RFC 8141.
+var urnRegexp = regexp.MustCompile(`^(?i:urn):[a-zA-Z0-9][a-zA-Z0-9-]{0,30}[a-zA-Z0-9]:` +
+	`([a-zA-Z0-9\-._~!$&'()*+,;=:@/]|%[0-9a-fA-F]{2})+` +
+	`(\?\+([a-zA-Z0-9\-._~!$&'()*+,;=:@/?]|%[0-9a-fA-F]{2})+)?` +
+	`(\?=([a-zA-Z0-9\-._~!$&'()*+,;=:@/?]|%[0-9a-fA-F]{2})+)?` +
+	`(#([a-zA-Z0-9\-._~!$&'()*+,;=:@/?]|%[0-9a-fA-F]{2})*)?$`)
@@ -7,45 +7,180 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
 )
 
 type Validator struct {
 	UnmaskedValue string
 	*Errors
 
-	min            string
-	max            string
-	exactLength    string
-	minLength      string
-	maxLength      string
-	notEmpty       bool
-	digit          bool
-	alpha          bool
-	alphanumeric   bool
-	ascii          bool
-	printableASCII bool
-	lowerCase      bool
-	upperCase      bool
-	int            bool
-	float          bool
-	url            bool
-	domain         bool
-	email          bool
-	semver         bool
-	uuid           bool
-	base64         bool
-	json           bool
-	pattern        string
-	enum           string
-	timestamp      string
+	min             string
+	max             string
+	exactLength     string
+	minLength       string
+	maxLength       string
+	lengthUnit      string
+	notEmpty        bool
+	digit           bool
+	alpha           bool
+	alphanumeric    bool
+	ascii           bool
+	printableASCII  bool
+	normalized      string
+	script          string
+	unicodeCategory string
+	lowerCase       bool
+	upperCase       bool
+	int             bool
+	float           bool
+	url             bool
+	domain          bool
+	email           bool
+	semver          bool
+	uuid            bool
+	base64          bool
+	json            bool
+	pattern         string
+	enum            string
+	timestamp       string
+
+	ip        bool
+	ipv4      bool
+	ipv6      bool
+	cidr      bool
+	port      bool
+	mac       bool
+	hostPort  bool
+	ipIn      string
+	ipExclude string
+
+	uri               bool
+	urlReference      bool
+	urn               bool
+	urlSchemes        string
+	urlRequireHTTPS   bool
+	urlAllowedHosts   string
+	urlDeniedHosts    string
+	urlForbidUserinfo bool
+	urlForbidIPHost   bool
+	urlQuery          string
+	urlFragment       string
+
+	idn             bool
+	emailMode       string
+	emailDomains    string
+	emailForbidPlus bool
+
+	awsARN              bool
+	awsARNServices      string
+	awsARNResourceTypes string
+	awsAccountID        bool
+	awsRegion           bool
+	gcpProjectID        bool
+	azureResourceID     bool
+
+	imageRef               bool
+	imageRequireDigest     bool
+	imageAllowedRegistries string
+	imageForbidLatest      bool
+	digest                 string
+
+	gitRefName        bool
+	gitBranchPatterns string
+	commitSHA         string
+	gitTagAbsent      bool
+	gitRefExists      bool
+
+	githubRepo         bool
+	githubUser         bool
+	githubActionRef    bool
+	githubActionPinned bool
+
+	k8sName          string
+	k8sLabelKey      bool
+	k8sLabelValue    bool
+	k8sAnnotationKey bool
+	k8sQuantity      bool
+
+	semverAllowV       bool
+	semverRange        string
+	semverNoPrerelease bool
+	semverGreaterThan  string
+
+	versionScheme string
+	minVersion    string
+	maxVersion    string
+
+	spdx        bool
+	spdxAllowed string
+	spdxDenied  string
+
+	conventionalCommit                 bool
+	conventionalCommitTypes            string
+	conventionalCommitScopes           string
+	conventionalCommitMaxSubjectLength string
+	conventionalCommitSubjectCase      string
+
+	dsn               string
+	dsnRequireSSLMode bool
+
+	pem             string
+	certNotExpired  bool
+	certMinValidity string
+	certHostname    string
+	sshPublicKey    bool
+	gpgFingerprint  bool
+	keyAlgorithms   string
+	minKeySize      string
+
+	jwt           bool
+	jwtAlgorithms string
+	jwtIssuers    string
+	jwtAudiences  string
+	jwtKey        string
+
+	passwordPolicy      bool
+	passwordMinLength   string
+	passwordRequire     string
+	passwordMaxRepeat   string
+	passwordMaxSequence string
+	passwordDictionary  string
+	passwordMinEntropy  string
+
+	notBreached string
+	noSecrets   bool
+
+	safeFor string
+
+	noInvisible    bool
+	noBidiControls bool
+	noConfusables  bool
+	noMixedScripts bool
+
+	startsWith     string
+	startsWithAny  string
+	endsWith       string
+	endsWithAny    string
+	contains       string
+	containsAny    string
+	notContains    string
+	notContainsAny string
+	ignoreCase     bool
+
+	charset    string
+	notCharset string
 }
 
 func (v *Validator) Validate() error {
 	v.minValidate()
 	v.maxValidate()
+	v.lengthUnitValidate()
 	v.exactLengthValidate()
 	v.minLengthValidate()
 	v.maxLengthValidate()
@@ -55,6 +190,9 @@ func (v *Validator) Validate() error {
 	v.alphanumericValidate()
 	v.asciiValidate()
 	v.printableASCIIValidate()
+	v.normalizedValidate()
+	v.scriptValidate()
+	v.unicodeCategoryValidate()
 	v.lowerCaseValidate()
 	v.upperCaseValidate()
 	v.intValidate()
@@ -69,6 +207,60 @@ func (v *Validator) Validate() error {
 	v.patternValidate()
 	v.enumValidate()
 	v.timestampValidate()
+	v.ipValidate()
+	v.ipv4Validate()
+	v.ipv6Validate()
+	v.cidrValidate()
+	v.portValidate()
+	v.macValidate()
+	v.hostPortValidate()
+	v.ipInValidate()
+	v.ipExcludeValidate()
+	v.uriValidate()
+	v.urlReferenceValidate()
+	v.urnValidate()
+	v.urlPolicyValidate()
+	v.awsARNValidate()
+	v.awsAccountIDValidate()
+	v.awsRegionValidate()
+	v.gcpProjectIDValidate()
+	v.azureResourceIDValidate()
+	v.imageRefValidate()
+	v.digestValidate()
+	v.gitRefNameValidate()
+	v.gitBranchPatternsValidate()
+	v.commitSHAValidate()
+	v.gitTagAbsentValidate()
+	v.gitRefExistsValidate()
+	v.githubRepoValidate()
+	v.githubUserValidate()
+	v.githubActionRefValidate()
+	v.k8sNameValidate()
+	v.k8sLabelKeyValidate()
+	v.k8sLabelValueValidate()
+	v.k8sAnnotationKeyValidate()
+	v.k8sQuantityValidate()
+	v.semverPolicyValidate()
+	v.versionSchemeValidate()
+	v.spdxValidate()
+	v.conventionalCommitValidate()
+	v.dsnValidate()
+	v.pemValidate()
+	v.sshPublicKeyValidate()
+	v.gpgFingerprintValidate()
+	v.keyPolicyValidate()
+	v.jwtValidate()
+	v.passwordPolicyValidate()
+	v.notBreachedValidate()
+	v.noSecretsValidate()
+	v.safeForValidate()
+	v.noInvisibleValidate()
+	v.noBidiControlsValidate()
+	v.noConfusablesValidate()
+	v.noMixedScriptsValidate()
+	v.substringValidate()
+	v.charsetValidate()
+	v.notCharsetValidate()
 
 	if !v.HasError() {
 		return nil
@@ -131,6 +323,15 @@ func (v *Validator) maxValidate() {
 	v.AddArgumentError(fmt.Errorf("--max cannot validate non-numeric value"))
 }
 
+func (v *Validator) lengthUnitValidate() {
+	if v.lengthUnit == "" {
+		return
+	}
+	if _, ok := lengthCounters[strings.ToLower(v.lengthUnit)]; !ok {
+		v.AddArgumentError(fmt.Errorf("--length-unit must be one of [bytes runes graphemes width]"))
+	}
+}
+
 func (v *Validator) exactLengthValidate() {
 	if v.exactLength == "" {
 		return
@@ -141,7 +342,7 @@ func (v *Validator) exactLengthValidate() {
 		v.AddArgumentError(fmt.Errorf("--exact-length must be an integer number"))
 		return
 	}
-	v.wrapValidate(validation.Length(number, number))
+	v.wrapValidate(v.lengthRule(number, number))
 }
 
 func (v *Validator) minLengthValidate() {
@@ -154,7 +355,7 @@ func (v *Validator) minLengthValidate() {
 		v.AddArgumentError(fmt.Errorf("--min-length must be an integer number"))
 		return
 	}
-	v.wrapValidate(validation.Length(number, 0))
+	v.wrapValidate(v.lengthRule(number, 0))
 }
 
 func (v *Validator) maxLengthValidate() {
@@ -167,7 +368,52 @@ func (v *Validator) maxLengthValidate() {
 		v.AddArgumentError(fmt.Errorf("--max-length must be an integer number"))
 		return
 	}
-	v.wrapValidate(validation.Length(0, number))
+	v.wrapValidate(v.lengthRule(0, number))
+}
+
+// lengthRule returns the rule of validation.Length, which counts bytes for strings,
+// or the rule counting the length in the unit specified by --length-unit with the same error messages.
+func (v *Validator) lengthRule(min int, max int) validation.Rule {
+	count, ok := lengthCounters[strings.ToLower(v.lengthUnit)]
+	if !ok {
+		return validation.Length(min, max)
+	}
+	return validation.By(func(value any) error {
+		s, _ := value.(string)
+		if s == "" {
+			return nil
+		}
+		length := count(s)
+		if min > 0 && length < min || max > 0 && length > max || min == 0 && max == 0 && length > 0 {
+			return lengthError(min, max)
+		}
+		return nil
+	})
+}
+
+// lengthError returns the same error as validation.Length returns.
+func lengthError(min int, max int) validation.Error {
+	err := validation.ErrLengthEmptyRequired
+	switch {
+	case min == 0 && max > 0:
+		err = validation.ErrLengthTooLong
+	case min > 0 && max == 0:
+		err = validation.ErrLengthTooShort
+	case min > 0 && min == max:
+		err = validation.ErrLengthInvalid
+	case min > 0 && max > 0:
+		err = validation.ErrLengthOutOfRange
+	}
+	return err.SetParams(map[string]any{"min": min, "max": max})
+}
+
+// lengthCounters counts the length of the value. The graphemes are the user-perceived characters
+// such as emoji sequences, and the width is the number of terminal columns following East Asian Width.
+var lengthCounters = map[string]func(string) int{
+	"bytes":     func(s string) int { return len(s) },
+	"runes":     utf8.RuneCountInString,
+	"graphemes": uniseg.GraphemeClusterCount,
+	"width":     uniseg.StringWidth,
 }
 
 func (v *Validator) notEmptyValidate() {
@@ -212,6 +458,91 @@ func (v *Validator) printableASCIIValidate() {
 	v.wrapValidate(is.PrintableASCII)
 }
 
+func (v *Validator) normalizedValidate() {
+	if v.normalized == "" {
+		return
+	}
+
+	forms := map[string]norm.Form{"nfc": norm.NFC, "nfd": norm.NFD, "nfkc": norm.NFKC, "nfkd": norm.NFKD}
+	lowerNormalized := strings.ToLower(v.normalized)
+	form, ok := forms[lowerNormalized]
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--normalized must be one of [nfc nfd nfkc nfkd]"))
+		return
+	}
+	v.wrapValidate(validation.NewStringRule(form.IsNormalString, fmt.Sprintf("must be in Unicode normalization form %s", strings.ToUpper(lowerNormalized))))
+}
+
+func (v *Validator) scriptValidate() {
+	if v.script == "" {
+		return
+	}
+
+	var scripts []string
+	for _, name := range splitList(v.script) {
+		index := slices.IndexFunc(unicodeScriptNames, func(script string) bool { return strings.EqualFold(script, name) })
+		if index < 0 {
+			v.AddArgumentError(fmt.Errorf("--script \"%s\" is not a valid Unicode script", name))
+			return
+		}
+		scripts = append(scripts, unicodeScriptNames[index])
+	}
+
+	// characters of the Common and Inherited scripts, such as digits, punctuation and combining marks, are always allowed
+	i, r, ok := findRune(v.UnmaskedValue, func(r rune) bool { return scriptOf(r) != "" && !slices.Contains(scripts, scriptOf(r)) })
+	if ok {
+		issue := fmt.Sprintf("must only contain characters of the scripts %v", scripts)
+		v.AddValidationError(newDetailedError(issue, fmt.Sprintf("found %s %s at index %d", scriptOf(r), codePoint(r), i)))
+	}
+}
+
+func (v *Validator) unicodeCategoryValidate() {
+	if v.unicodeCategory == "" {
+		return
+	}
+
+	var categories []string
+	for _, name := range splitList(v.unicodeCategory) {
+		// the regular expression syntax like \p{L} is accepted as well
+		name = strings.TrimSuffix(strings.TrimPrefix(name, `\p{`), "}")
+		if _, ok := unicode.Categories[name]; !ok {
+			v.AddArgumentError(fmt.Errorf("--unicode-category \"%s\" is not a valid Unicode general category", name))
+			return
+		}
+		categories = append(categories, name)
+	}
+
+	i, r, ok := findRune(v.UnmaskedValue, func(r rune) bool {
+		return !slices.ContainsFunc(categories, func(category string) bool { return unicode.Is(unicode.Categories[category], r) })
+	})
+	if ok {
+		issue := fmt.Sprintf("must only contain characters of the categories %v", categories)
+		v.AddValidationError(newDetailedError(issue, fmt.Sprintf("found %s %s at index %d", categoryOf(r), codePoint(r), i)))
+	}
+}
+
+// categoryOf returns the two-letter general category of the character, such as "Lu" and "Nd",
+// or "Cn" for unassigned code points.
+func categoryOf(r rune) string {
+	for name, table := range unicode.Categories {
+		if len(name) == 2 && name != "LC" && unicode.Is(table, r) {
+			return name
+		}
+	}
+	return "Cn"
+}
+
+var unicodeScriptNames = newUnicodeScriptNames()
+
+func newUnicodeScriptNames() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
 func (v *Validator) lowerCaseValidate() {
 	if !v.lowerCase {
 		return
@@ -251,14 +582,69 @@ func (v *Validator) domainValidate() {
 	if !v.domain {
 		return
 	}
-	v.wrapValidate(is.Domain)
+
+	if !v.idn {
+		v.wrapValidate(is.Domain)
+		return
+	}
+	value, err := toASCIIDomain(v.UnmaskedValue)
+	if err != nil {
+		v.AddValidationError(is.ErrDomain)
+		return
+	}
+	v.wrapAnyValidate(value, is.Domain)
 }
 
 func (v *Validator) emailValidate() {
 	if !v.email {
 		return
 	}
-	v.wrapValidate(is.EmailFormat)
+
+	validators := map[string]func(value string, idn bool) bool{
+		"loose":   isLooseEmail,
+		"rfc5322": isRFC5322Email,
+		"html5":   isHTML5Email,
+	}
+	mode := strings.ToLower(v.emailMode)
+	if mode == "" {
+		mode = "loose"
+	}
+	validator, ok := validators[mode]
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--email-mode must be one of [loose rfc5322 html5]"))
+		return
+	}
+	if !v.validHostPatterns("--email-domains", v.emailDomains) {
+		return
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	value := v.UnmaskedValue
+	if v.idn {
+		ascii, err := toASCIIEmail(value)
+		if err != nil {
+			v.AddValidationError(is.ErrEmail)
+			return
+		}
+		value = ascii
+	}
+	if !validator(value, v.idn) {
+		v.AddValidationError(is.ErrEmail)
+		return
+	}
+
+	local, domain := splitEmail(value)
+	if v.emailDomains != "" {
+		domains := splitList(v.emailDomains)
+		if !matchHostPatterns(toASCIIDomains(domains), domain) {
+			v.AddValidationError(fmt.Errorf("domain must match one of %v", domains))
+		}
+	}
+	if v.emailForbidPlus && strings.Contains(local, "+") {
+		v.AddValidationError(fmt.Errorf("must not use plus addressing"))
+	}
 }
 
 func (v *Validator) semverValidate() {
@@ -127,6 +127,56 @@ func TestValidator_maxLengthValidate(t *testing.T) {
 	}
 }
 
+func TestValidator_lengthUnit(t *testing.T) {
+	family := "\U0001F468\u200D\U0001F469\u200D\U0001F467"
+	cases := []struct {
+		annotation  string
+		value       string
+		unit        string
+		exactLength string
+		minLength   string
+		maxLength   string
+		expected    string
+	}{
+		{"default bytes", "h\u00e9llo", "", "", "", "5", "the length must be no more than 5"},
+		{"bytes", "h\u00e9llo", "bytes", "6", "", "", ""},
+		{"runes", "h\u00e9llo", "runes", "5", "", "", ""},
+		{"runes too long", "\u65e5\u672c\u8a9e", "runes", "", "", "2", "the length must be no more than 2"},
+		{"graphemes emoji", family, "graphemes", "1", "", "", ""},
+		{"graphemes combining", "e\u0301", "Graphemes", "1", "", "", ""},
+		{"graphemes too short", family, "graphemes", "", "2", "", "the length must be no less than 2"},
+		{"width wide", "\u65e5\u672c\u8a9e", "width", "6", "", "", ""},
+		{"width emoji", family, "width", "", "", "2", ""},
+		{"width too wide", "\u65e5\u672c\u8a9eabc", "width", "", "", "8", "the length must be no more than 8"},
+		{"width exact", "abc", "width", "4", "", "", "the length must be exactly 4"},
+		{"empty", "", "width", "", "1", "", ""},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.lengthUnit = tc.unit
+		sut.exactLength = tc.exactLength
+		sut.minLength = tc.minLength
+		sut.maxLength = tc.maxLength
+		sut.lengthUnitValidate()
+		sut.exactLengthValidate()
+		sut.minLengthValidate()
+		sut.maxLengthValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}
+
+func TestValidator_lengthUnitValidate_ArgumentError(t *testing.T) {
+	sut := newValidatorSut("abc")
+	sut.lengthUnit = "columns"
+	sut.lengthUnitValidate()
+
+	expected := "Argument error: --length-unit must be one of [bytes runes graphemes width]."
+	if sut.Errors.Error() != expected {
+		t.Errorf(fmt.Sprintf("\n expected: %s\n actual:   %s", expected, sut.Errors.Error()))
+	}
+}
+
 func TestValidator_notEmptyValidate(t *testing.T) {
 	cases := []struct {
 		annotation string
@@ -247,6 +297,134 @@ func TestValidator_printableASCIIValidate(t *testing.T) {
 	}
 }
 
+func TestValidator_normalizedValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "caf\u00e9", "nfc", ""},
+		{"valid2", "cafe\u0301", "NFD", ""},
+		{"valid3", "abc", "nfkc", ""},
+		{"valid4", "", "nfc", ""},
+		{"invalid1", "cafe\u0301", "nfc", "must be in Unicode normalization form NFC"},
+		{"invalid2", "caf\u00e9", "nfd", "must be in Unicode normalization form NFD"},
+		{"invalid3", "\uff21\uff22", "nfkc", "must be in Unicode normalization form NFKC"},
+		{"invalid4", "\ufb01le", "nfkd", "must be in Unicode normalization form NFKD"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.normalized = tc.argument
+		sut.normalizedValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_scriptValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "Hello, world 123!", "Latin", ""},
+		{"valid2", "東京タワーとTokyo", "latin,han,hiragana,katakana", ""},
+		{"valid3", "Привет", "Cyrillic", ""},
+		{"valid4", "", "Latin", ""},
+		{"invalid1", "p\u0430ypal", "Latin", "must only contain characters of the scripts [Latin], but found Cyrillic U+0430 at index 1"},
+		{"invalid2", "東京タワー", "Han,Hiragana", "must only contain characters of the scripts [Han Hiragana], but found Katakana U+30BF at index 2"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.script = tc.argument
+		sut.scriptValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_unicodeCategoryValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		argument   string
+		expected   string
+	}{
+		{"valid1", "abcÄÖ123", "L,Nd", ""},
+		{"valid2", "日本語", `\p{L}`, ""},
+		{"valid3", "ABC", "Lu", ""},
+		{"valid4", "", "L", ""},
+		{"valid5", "12\u0663", `\p{Nd},\p{Lu}`, ""},
+		{"invalid1", "abc-123", "L,Nd", "must only contain characters of the categories [L Nd], but found Pd U+002D at index 3"},
+		{"invalid2", "ABc", "Lu", "must only contain characters of the categories [Lu], but found Ll U+0063 at index 2"},
+		{"invalid3", "½", "Nd", "must only contain characters of the categories [Nd], but found No U+00BD at index 0"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.unicodeCategory = tc.argument
+		sut.unicodeCategoryValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.argument)
+	}
+}
+
+func TestValidator_unicodeMasked(t *testing.T) {
+	cases := []struct {
+		annotation      string
+		script          string
+		unicodeCategory string
+		expected        string
+	}{
+		{"script", "Latin", "", `Validation error: The specified value "***" is invalid. Issues: must only contain characters of the scripts [Latin].`},
+		{"unicode-category", "", "L", `Validation error: The specified value "***" is invalid. Issues: must only contain characters of the categories [L].`},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("p\u0430ss1")
+		sut.MaskValue()
+		sut.script = tc.script
+		sut.unicodeCategory = tc.unicodeCategory
+		sut.scriptValidate()
+		sut.unicodeCategoryValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
+
+func TestValidator_unicodeArgumentError(t *testing.T) {
+	cases := []struct {
+		annotation      string
+		normalized      string
+		script          string
+		unicodeCategory string
+		expected        string
+	}{
+		{"normalized", "nfx", "", "", "Argument error: --normalized must be one of [nfc nfd nfkc nfkd]."},
+		{"script", "", "Latin,Klingon", "", `Argument error: --script "Klingon" is not a valid Unicode script.`},
+		{"unicode-category", "", "", `\p{Letter}`, `Argument error: --unicode-category "Letter" is not a valid Unicode general category.`},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut("abc")
+		sut.normalized = tc.normalized
+		sut.script = tc.script
+		sut.unicodeCategory = tc.unicodeCategory
+		sut.normalizedValidate()
+		sut.scriptValidate()
+		sut.unicodeCategoryValidate()
+
+		format := "\n annotation: %s\n expected:   %s\n actual:     %s"
+		if sut.Errors.Error() != tc.expected {
+			t.Errorf(fmt.Sprintf(format, tc.annotation, tc.expected, sut.Errors.Error()))
+		}
+	}
+}
+
 func TestValidator_lowerCaseValidate(t *testing.T) {
 	cases := []struct {
 		annotation string
@@ -0,0 +1,351 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func (v *Validator) versionSchemeValidate() {
+	if v.versionScheme == "" {
+		if v.minVersion != "" || v.maxVersion != "" {
+			v.AddArgumentError(fmt.Errorf("--min-version and --max-version require --version-scheme"))
+		}
+		return
+	}
+
+	schemes := map[string]func(string) (version, bool){
+		"semver": func(value string) (version, bool) { return parseSemverVersion(value, v.semverAllowV) },
+		"calver": parseCalVer,
+		"pep440": parsePEP440,
+		"debian": parseDebianVersion,
+		"go":     parseGoVersion,
+	}
+	scheme := strings.ToLower(v.versionScheme)
+	parse, ok := schemes[scheme]
+	if !ok {
+		v.AddArgumentError(fmt.Errorf("--version-scheme must be one of [semver calver pep440 debian go]"))
+		return
+	}
+
+	var lower, upper version
+	if v.minVersion != "" {
+		if lower, ok = parse(v.minVersion); !ok {
+			v.AddArgumentError(fmt.Errorf("--min-version \"%s\" is not a valid %s version", v.minVersion, scheme))
+			return
+		}
+	}
+	if v.maxVersion != "" {
+		if upper, ok = parse(v.maxVersion); !ok {
+			v.AddArgumentError(fmt.Errorf("--max-version \"%s\" is not a valid %s version", v.maxVersion, scheme))
+			return
+		}
+	}
+	if v.UnmaskedValue == "" {
+		return
+	}
+
+	value, ok := parse(v.UnmaskedValue)
+	if !ok {
+		v.AddValidationError(fmt.Errorf("must be a valid %s version", scheme))
+		return
+	}
+	if lower != nil && value.compare(lower) < 0 {
+		v.AddValidationError(fmt.Errorf("must be no less than %s", v.minVersion))
+	}
+	if upper != nil && value.compare(upper) > 0 {
+		v.AddValidationError(fmt.Errorf("must be no greater than %s", v.maxVersion))
+	}
+}
+
+// version is a parsed version that can be compared with other versions of the same scheme.
+// compare returns a negative number, zero or a positive number like strings.Compare.
+type version interface {
+	compare(other version) int
+}
+
+func parseSemverVersion(value string, allowV bool) (version, bool) {
+	semver, ok := parseSemver(value, allowV)
+	if !ok {
+		return nil, false
+	}
+	return semverVersion{semver}, true
+}
+
+type semverVersion struct {
+	*semVersion
+}
+
+func (s semverVersion) compare(other version) int {
+	return s.semVersion.compare(other.(semverVersion).semVersion)
+}
+
+// parseGoVersion parses Go module versions, including pseudo-versions and +incompatible versions.
+// See https://go.dev/ref/mod#versions for details.
+func parseGoVersion(value string) (version, bool) {
+	semver, ok := parseSemver(strings.TrimPrefix(value, "v"), false)
+	if !ok || !strings.HasPrefix(value, "v") {
+		return nil, false
+	}
+	if semver.build != "" && (semver.build != "incompatible" || semver.major < 2) {
+		return nil, false
+	}
+	if pseudo := strings.Join(semver.prerelease, "."); goPseudoVersionRegexp.MatchString(pseudo) {
+		if !goPseudoVersionTimestampRegexp.MatchString(pseudo) {
+			return nil, false
+		}
+	}
+	return semverVersion{semver}, true
+}
+
+// calVer represents calendar versions in the form of YYYY.MM[.MICRO].
+type calVer [3]uint64
+
+func parseCalVer(value string) (version, bool) {
+	matches := calVerRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return nil, false
+	}
+	var result calVer
+	for i, match := range matches[1:] {
+		if match != "" {
+			result[i], _ = strconv.ParseUint(match, 10, 64)
+		}
+	}
+	return result, true
+}
+
+func (c calVer) compare(other version) int {
+	o := other.(calVer)
+	return slices.Compare(c[:], o[:])
+}
+
+// pep440Version represents Python package versions defined in PEP 440.
+type pep440Version struct {
+	epoch   uint64
+	release []uint64
+	pre     *pep440Segment
+	post    *pep440Segment
+	dev     *pep440Segment
+	local   []string
+}
+
+type pep440Segment struct {
+	label  string
+	number uint64
+}
+
+func parsePEP440(value string) (version, bool) {
+	matches := pep440Regexp.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if matches == nil {
+		return nil, false
+	}
+	group := func(name string) string { return matches[pep440Regexp.SubexpIndex(name)] }
+	number := func(s string) uint64 { n, _ := strconv.ParseUint(s, 10, 64); return n }
+
+	result := &pep440Version{epoch: number(group("epoch"))}
+	for _, part := range strings.Split(group("release"), ".") {
+		result.release = append(result.release, number(part))
+	}
+	if label := group("pre_l"); label != "" {
+		labels := map[string]string{"alpha": "a", "a": "a", "beta": "b", "b": "b", "c": "rc", "rc": "rc", "pre": "rc", "preview": "rc"}
+		result.pre = &pep440Segment{labels[label], number(group("pre_n"))}
+	}
+	if group("post") != "" {
+		result.post = &pep440Segment{"post", number(group("post_n1") + group("post_n2"))}
+	}
+	if group("dev") != "" {
+		result.dev = &pep440Segment{"dev", number(group("dev_n"))}
+	}
+	if local := group("local"); local != "" {
+		result.local = strings.FieldsFunc(local, func(r rune) bool { return r == '-' || r == '_' || r == '.' })
+	}
+	return result, true
+}
+
+// compare follows the ordering of the "packaging" library, the reference implementation of PEP 440.
+func (p *pep440Version) compare(other version) int {
+	o := other.(*pep440Version)
+	if c := compareUint(p.epoch, o.epoch); c != 0 {
+		return c
+	}
+	if c := slices.Compare(trimTrailingZeros(p.release), trimTrailingZeros(o.release)); c != 0 {
+		return c
+	}
+	if c := compareInt(p.preRank(), o.preRank()); c != 0 {
+		return c
+	}
+	if p.pre != nil && o.pre != nil {
+		if c := strings.Compare(p.pre.label, o.pre.label); c != 0 {
+			return c
+		}
+		if c := compareUint(p.pre.number, o.pre.number); c != 0 {
+			return c
+		}
+	}
+	if c := compareOptionalSegment(p.post, o.post, -1); c != 0 {
+		return c
+	}
+	if c := compareOptionalSegment(p.dev, o.dev, 1); c != 0 {
+		return c
+	}
+	return comparePEP440Local(p.local, o.local)
+}
+
+// preRank sorts developmental releases without pre/post segments (1.0.dev0) before prereleases,
+// and prereleases before final releases.
+func (p *pep440Version) preRank() int {
+	switch {
+	case p.pre == nil && p.post == nil && p.dev != nil:
+		return 0
+	case p.pre != nil:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// compareOptionalSegment compares segments where a missing segment sorts as absent (-1 or +1).
+func compareOptionalSegment(a *pep440Segment, b *pep440Segment, absent int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return absent
+	case b == nil:
+		return -absent
+	default:
+		return compareUint(a.number, b.number)
+	}
+}
+
+// comparePEP440Local compares local version labels, where numeric segments sort after alphanumeric ones.
+func comparePEP440Local(a []string, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		aNumber, aErr := strconv.ParseUint(a[i], 10, 64)
+		bNumber, bErr := strconv.ParseUint(b[i], 10, 64)
+		var c int
+		switch {
+		case aErr == nil && bErr == nil:
+			c = compareUint(aNumber, bNumber)
+		case aErr == nil:
+			c = 1
+		case bErr == nil:
+			c = -1
+		default:
+			c = strings.Compare(a[i], b[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+func trimTrailingZeros(values []uint64) []uint64 {
+	end := len(values)
+	for end > 0 && values[end-1] == 0 {
+		end--
+	}
+	return values[:end]
+}
+
+// debianVersion represents Debian package versions in the form of [epoch:]upstream_version[-debian_revision].
+type debianVersion struct {
+	epoch    uint64
+	upstream string
+	revision string
+}
+
+func parseDebianVersion(value string) (version, bool) {
+	result := &debianVersion{upstream: value}
+	if epoch, rest, found := strings.Cut(value, ":"); found {
+		number, err := strconv.ParseUint(epoch, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		result.epoch = number
+		result.upstream = rest
+	}
+	if i := strings.LastIndex(result.upstream, "-"); i >= 0 {
+		result.revision = result.upstream[i+1:]
+		result.upstream = result.upstream[:i]
+		if !debianRevisionRegexp.MatchString(result.revision) {
+			return nil, false
+		}
+	}
+	if !debianUpstreamRegexp.MatchString(result.upstream) {
+		return nil, false
+	}
+	return result, true
+}
+
+// compare follows the algorithm of dpkg --compare-versions.
+func (d *debianVersion) compare(other version) int {
+	o := other.(*debianVersion)
+	if c := compareUint(d.epoch, o.epoch); c != 0 {
+		return c
+	}
+	if c := compareDebianString(d.upstream, o.upstream); c != 0 {
+		return c
+	}
+	return compareDebianString(d.revision, o.revision)
+}
+
+// compareDebianString compares alternating non-digit and digit parts, where "~" sorts before anything,
+// even the end of a part, and letters sort before non-letters.
+func compareDebianString(a string, b string) int {
+	for a != "" || b != "" {
+		for (a != "" && !isDigit(a[0])) || (b != "" && !isDigit(b[0])) {
+			if c := compareInt(debianOrder(a), debianOrder(b)); c != 0 {
+				return c
+			}
+			a, b = a[min(1, len(a)):], b[min(1, len(b)):]
+		}
+
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		aDigits, bDigits := len(a)-len(strings.TrimLeft(a, "0123456789")), len(b)-len(strings.TrimLeft(b, "0123456789"))
+		if c := compareInt(aDigits, bDigits); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a[:aDigits], b[:bDigits]); c != 0 {
+			return c
+		}
+		a, b = a[aDigits:], b[bDigits:]
+	}
+	return 0
+}
+
+func debianOrder(value string) int {
+	switch {
+	case value == "" || isDigit(value[0]):
+		return 256
+	case value[0] == '~':
+		return 0
+	case ('a' <= value[0] && value[0] <= 'z') || ('A' <= value[0] && value[0] <= 'Z'):
+		return 256 + int(value[0])
+	default:
+		return 512 + int(value[0])
+	}
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+var goPseudoVersionRegexp = regexp.MustCompile(`(^|\.)0\.[0-9]{14}-[0-9a-f]{12}$|^[0-9]{14}-[0-9a-f]{12}$`)
+var goPseudoVersionTimestampRegexp = regexp.MustCompile(`[0-9]{4}(0[1-9]|1[0-2])(0[1-9]|[12][0-9]|3[01])([01][0-9]|2[0-3])[0-5][0-9][0-5][0-9]-[0-9a-f]{12}$`)
+var calVerRegexp = regexp.MustCompile(`^([0-9]{4})\.(0?[1-9]|1[0-2])(?:\.(0|[1-9][0-9]*))?$`)
+var debianUpstreamRegexp = regexp.MustCompile(`^[0-9][A-Za-z0-9.+~:-]*$`)
+var debianRevisionRegexp = regexp.MustCompile(`^[A-Za-z0-9.+~]+$`)
+
+// pep440Regexp is derived from VERSION_PATTERN of the "packaging" library.
+var pep440Regexp = regexp.MustCompile(`^v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>(?:-(?P<post_n1>[0-9]+))|(?:[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?))?` +
+	`(?P<dev>[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestValidator_versionSchemeValidate(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		scheme     string
+		expected   string
+	}{
+		{"semver_valid", "1.2.3-rc.1", "semver", ""},
+		{"semver_invalid", "1.2", "semver", "must be a valid semver version"},
+		{"calver_valid1", "2024.08.1", "calver", ""},
+		{"calver_valid2", "2024.12", "CalVer", ""},
+		{"calver_invalid", "2024.13.0", "calver", "must be a valid calver version"},
+		{"pep440_valid1", "1.0.0a1", "pep440", ""},
+		{"pep440_valid2", "2!1.0.post1.dev2+ubuntu.1", "pep440", ""},
+		{"pep440_valid3", "1.0-RC-1", "pep440", ""},
+		{"pep440_invalid", "1.0.0-beta+", "pep440", "must be a valid pep440 version"},
+		{"debian_valid1", "1:2.30-1ubuntu1~22.04", "debian", ""},
+		{"debian_valid2", "2.30", "debian", ""},
+		{"debian_invalid1", "a2.30-1", "debian", "must be a valid debian version"},
+		{"debian_valid3", "2:30:1", "debian", ""},
+		{"debian_invalid2", "2.30_1", "debian", "must be a valid debian version"},
+		{"go_valid1", "v1.2.3", "go", ""},
+		{"go_valid2", "v0.0.0-20191109021931-daa7c04131f5", "go", ""},
+		{"go_valid3", "v1.2.4-0.20191109021931-daa7c04131f5", "go", ""},
+		{"go_valid4", "v2.0.0+incompatible", "go", ""},
+		{"go_invalid1", "1.2.3", "go", "must be a valid go version"},
+		{"go_invalid2", "v1.0.0+incompatible", "go", "must be a valid go version"},
+		{"go_invalid3", "v0.0.0-20191309021931-daa7c04131f5", "go", "must be a valid go version"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.versionScheme = tc.scheme
+		sut.versionSchemeValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.scheme)
+	}
+}
+
+func TestValidator_versionSchemeValidate_Bounds(t *testing.T) {
+	cases := []struct {
+		annotation string
+		value      string
+		scheme     string
+		min        string
+		max        string
+		expected   string
+	}{
+		{"semver_valid", "1.5.0", "semver", "1.4.0", "2.0.0", ""},
+		{"semver_invalid", "2.0.1", "semver", "1.4.0", "2.0.0", "must be no greater than 2.0.0"},
+		{"calver_valid", "2024.8.1", "calver", "2024.08", "2024.12", ""},
+		{"calver_invalid", "2024.7.9", "calver", "2024.08", "", "must be no less than 2024.08"},
+		{"pep440_pre_before_final", "1.0rc1", "pep440", "1.0", "", "must be no less than 1.0"},
+		{"pep440_dev_before_pre", "1.0.dev1", "pep440", "1.0a1", "", "must be no less than 1.0a1"},
+		{"pep440_post_after_final", "1.0.post1", "pep440", "", "1.0", "must be no greater than 1.0"},
+		{"pep440_trailing_zeros", "1.0.0", "pep440", "1", "1.0", ""},
+		{"pep440_epoch", "1!0.1", "pep440", "", "2.0", "must be no greater than 2.0"},
+		{"pep440_local", "1.0+local.1", "pep440", "", "1.0", "must be no greater than 1.0"},
+		{"debian_tilde_before_release", "1.0~rc1", "debian", "1.0", "", "must be no less than 1.0"},
+		{"debian_numeric", "1.10-1", "debian", "1.9-1", "", ""},
+		{"debian_revision", "1.0-1ubuntu1", "debian", "", "1.0-1", "must be no greater than 1.0-1"},
+		{"debian_epoch", "1:0.1", "debian", "", "2.0", "must be no greater than 2.0"},
+		{"debian_letters_before_symbols", "1.0a", "debian", "1.0+", "", "must be no less than 1.0+"},
+		{"go_pseudo_before_release", "v1.2.4-0.20191109021931-daa7c04131f5", "go", "v1.2.4", "", "must be no less than v1.2.4"},
+	}
+
+	for _, tc := range cases {
+		sut := newValidatorSut(tc.value)
+		sut.versionScheme = tc.scheme
+		sut.minVersion = tc.min
+		sut.maxVersion = tc.max
+		sut.versionSchemeValidate()
+		assert(t, tc.expected, sut.Errors, tc.value, tc.annotation)
+	}
+}